@@ -0,0 +1,192 @@
+// benchindex measures how the ticket index modes behave when several workers fetch tickets at the same time.
+// Each worker repeats fetching active tickets and releasing them, as the match loop does for the unmatched tickets.
+// Run it against a dedicated Redis, because it adds and removes tickets in the ticket index.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/HMasataka/collision/config"
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/collision/infrastructure"
+	"github.com/HMasataka/collision/infrastructure/driver"
+	"github.com/HMasataka/collision/infrastructure/persistence"
+	"github.com/jessevdk/go-flags"
+	"github.com/rs/xid"
+)
+
+type Options struct {
+	Modes    []string      `short:"m" long:"mode" description:"Ticket index mode to measure" choice:"script" choice:"lock" default:"lock" default:"script"`
+	Workers  int           `short:"w" long:"workers" description:"Number of concurrent workers" default:"8"`
+	Tickets  int           `short:"t" long:"tickets" description:"Number of tickets added to the ticket index" default:"20000"`
+	Limit    int64         `short:"l" long:"limit" description:"Number of tickets fetched at once" default:"100"`
+	Duration time.Duration `short:"d" long:"duration" description:"Duration of each measurement" default:"10s"`
+}
+
+type result struct {
+	fetches    int
+	fetched    int
+	duplicates int
+	latencies  []time.Duration
+}
+
+func main() {
+	var opts Options
+	parser := flags.NewParser(&opts, flags.Default)
+	if _, err := parser.Parse(); err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(1)
+	}
+
+	// The Redis address and the timeouts can be changed with the same environment variables as the server.
+	cfg, cfgErr := config.Load("")
+	if cfgErr != nil {
+		fmt.Fprintf(os.Stderr, "%v: %v\n", cfgErr, cfgErr.Unwrap())
+		os.Exit(1)
+	}
+
+	client := infrastructure.NewClient(cfg)
+	defer client.Close()
+
+	lockerDriver := driver.NewLockerDriver(infrastructure.NewLocker(cfg))
+	ticketIDRepository := persistence.NewTicketIDRepository(client)
+	pendingTicketRepository := persistence.NewPendingTicketRepository(client, cfg.Ticket.PendingReleaseTimeout)
+
+	fmt.Printf("workers=%d tickets=%d limit=%d duration=%s\n", opts.Workers, opts.Tickets, opts.Limit, opts.Duration)
+	fmt.Printf("%-8s %10s %12s %12s %12s %12s %10s\n", "mode", "fetches", "fetches/s", "tickets/s", "p50", "p99", "duplicates")
+
+	for _, mode := range opts.Modes {
+		var ticketIndexRepository repository.TicketIndexRepository
+		switch config.TicketIndexMode(mode) {
+		case config.TicketIndexLock:
+			ticketIndexRepository = persistence.NewLockedTicketIndexRepository(lockerDriver, ticketIDRepository, pendingTicketRepository)
+		default:
+			ticketIndexRepository = persistence.NewTicketIndexRepository(client, cfg.Ticket.PendingReleaseTimeout)
+		}
+
+		res, err := measure(context.Background(), opts, ticketIDRepository, ticketIndexRepository)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to measure %s: %+v\n", mode, err)
+			os.Exit(1)
+		}
+
+		slices.Sort(res.latencies)
+		fmt.Printf("%-8s %10d %12.1f %12.1f %12s %12s %10d\n",
+			mode,
+			res.fetches,
+			float64(res.fetches)/opts.Duration.Seconds(),
+			float64(res.fetched)/opts.Duration.Seconds(),
+			percentile(res.latencies, 0.50),
+			percentile(res.latencies, 0.99),
+			res.duplicates,
+		)
+	}
+}
+
+func measure(
+	ctx context.Context,
+	opts Options,
+	ticketIDRepository repository.TicketIDRepository,
+	ticketIndexRepository repository.TicketIndexRepository,
+) (*result, error) {
+	ticketIDs := make([]string, opts.Tickets)
+	for i := range ticketIDs {
+		ticketIDs[i] = xid.New().String()
+		if err := ticketIDRepository.Insert(ctx, ticketIDs[i]); err != nil {
+			return nil, err
+		}
+	}
+	// Remove the tickets added for the measurement.
+	defer func() {
+		_ = ticketIndexRepository.DeindexTickets(context.Background(), ticketIDs)
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, opts.Duration)
+	defer cancel()
+
+	var (
+		mutex   sync.Mutex
+		wg      sync.WaitGroup
+		res     result
+		errOnce error
+		held    = map[string]struct{}{}
+	)
+
+	for range opts.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for ctx.Err() == nil {
+				start := time.Now()
+
+				fetchedTicketIDs, err := ticketIndexRepository.FetchActiveTicketIDs(ctx, opts.Limit)
+				if err != nil {
+					if ctx.Err() == nil {
+						mutex.Lock()
+						errOnce = err
+						mutex.Unlock()
+						cancel()
+					}
+					return
+				}
+
+				elapsed := time.Since(start)
+
+				mutex.Lock()
+				res.fetches++
+				res.fetched += len(fetchedTicketIDs)
+				res.latencies = append(res.latencies, elapsed)
+				for _, ticketID := range fetchedTicketIDs {
+					// A ticket must not be fetched by another worker until it is released.
+					if _, ok := held[ticketID]; ok {
+						res.duplicates++
+					}
+					held[ticketID] = struct{}{}
+				}
+				mutex.Unlock()
+
+				if len(fetchedTicketIDs) == 0 {
+					continue
+				}
+
+				mutex.Lock()
+				for _, ticketID := range fetchedTicketIDs {
+					delete(held, ticketID)
+				}
+				mutex.Unlock()
+
+				if err := ticketIndexRepository.ReleaseTickets(context.Background(), fetchedTicketIDs); err != nil {
+					mutex.Lock()
+					errOnce = err
+					mutex.Unlock()
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if errOnce != nil {
+		return nil, errOnce
+	}
+
+	return &res, nil
+}
+
+func percentile(latencies []time.Duration, p float64) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+
+	return latencies[int(float64(len(latencies)-1)*p)].Round(time.Microsecond)
+}
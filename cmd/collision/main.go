@@ -2,70 +2,200 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
+	"github.com/HMasataka/collision/config"
 	"github.com/HMasataka/collision/di"
-	"github.com/HMasataka/collision/domain/entity"
 	"github.com/HMasataka/collision/gen/pb"
 	"github.com/HMasataka/collision/handler"
+	"github.com/HMasataka/collision/infrastructure/driver"
+	"github.com/HMasataka/collision/logging"
+	"github.com/HMasataka/collision/metrics"
+	"github.com/HMasataka/collision/tracing"
 	"github.com/HMasataka/collision/usecase"
+	"github.com/jessevdk/go-flags"
+	"github.com/rs/xid"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
-func getListener() (net.Listener, error) {
-	port := "31080"
-	address := fmt.Sprintf("127.0.0.1:%v", port)
+// shutdownTimeout is how long the servers wait for the requests in progress on shutdown.
+// The watches that are still open after it are closed.
+const shutdownTimeout = 10 * time.Second
 
+type Options struct {
+	DisableMatchLoop bool   `long:"disable-match-loop" description:"Disable the internal match loop and leave matchmaking to BackendService clients"`
+	Store            string `long:"store" description:"State store" choice:"redis" choice:"memory" default:"redis"`
+	Config           string `short:"c" long:"config" description:"Path to the YAML config file" env:"COLLISION_CONFIG"`
+}
+
+func getListener(address string) (net.Listener, error) {
 	listener, err := net.Listen("tcp", address)
 	if err != nil {
 		return nil, err
 	}
 
-	fmt.Println("Listening on", address)
+	slog.Info("listening", slog.String("address", address))
 
 	return listener, nil
 }
 
-var matchProfile = &entity.MatchProfile{
-	Name: "simple-1vs1",
-	Pools: []*entity.Pool{
-		{Name: "test-pool"},
-	},
-}
-
 func main() {
-	assigner := usecase.NewRandomAssigner()
+	var opts Options
+	parser := flags.NewParser(&opts, flags.Default)
+	if _, err := parser.Parse(); err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(1)
+	}
 
-	matchFunctions := map[*entity.MatchProfile]entity.MatchFunction{
-		matchProfile: usecase.NewSimple1vs1MatchFunction(),
+	cfg, err := config.Load(opts.Config)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v: %v\n", err, err.Unwrap())
+		os.Exit(1)
 	}
 
-	u := di.InitializeUseCase(context.Background(), matchFunctions, assigner, nil)
-	frontendHandler := handler.NewFrontend(u.TicketUsecase, u.AssignUsecase)
+	logger := logging.New(cfg.Log, os.Stderr)
+	slog.SetDefault(logger)
 
-	go func() {
-		ctx := context.Background()
-		if err := startMatchLoop(ctx, u.MatchUsecase); err != nil {
-			panic(err)
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		logger.Error("failed to start", logging.Error(err))
+		os.Exit(1)
+	}
+	// The tracer provider is shut down last, to export the spans of the servers and the match loop stopping.
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			logger.Error("failed to shut down tracing", logging.Error(err))
 		}
 	}()
 
-	if err := startFrontEndServer(frontendHandler); err != nil {
+	assigner, err := usecase.NewAssigner(cfg, logger)
+	if err != nil {
+		logger.Error("failed to start", logging.Error(err))
+		os.Exit(1)
+	}
+
+	evaluator, err := usecase.NewEvaluator(cfg)
+	if err != nil {
+		logger.Error("failed to start", logging.Error(err))
+		os.Exit(1)
+	}
+
+	profiles, err := cfg.MatchProfiles()
+	if err != nil {
+		logger.Error("failed to start", logging.Error(err))
+		os.Exit(1)
+	}
+
+	registry, err := usecase.NewMatchFunctionRegistry(cfg)
+	if err != nil {
+		logger.Error("failed to start", logging.Error(err))
+		os.Exit(1)
+	}
+
+	matchFunctions, err := config.BindMatchProfiles(profiles, registry)
+	if err != nil {
+		logger.Error("failed to start", logging.Error(err))
+		os.Exit(1)
+	}
+
+	eventSink, closeEventSink, err := driver.NewEventSinkDriver(cfg, logger)
+	if err != nil {
+		logger.Error("failed to start", logging.Error(err))
+		os.Exit(1)
+	}
+	// The sink is closed after the servers and the match loop have stopped emitting the events.
+	defer closeEventSink()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var u *usecase.UseCaseContainer
+	switch opts.Store {
+	case "memory":
+		u = di.InitializeInMemoryUseCase(context.Background(), cfg, logger, registry, matchFunctions, assigner, evaluator, eventSink)
+	default:
+		u = di.InitializeUseCase(context.Background(), cfg, logger, registry, matchFunctions, assigner, evaluator, eventSink)
+	}
+	frontendHandler := handler.NewFrontend(u.TicketUsecase, u.AssignUsecase, u.BackfillUsecase)
+	backendHandler := handler.NewBackend(u.MatchUsecase)
+	adminHandler := handler.NewAdmin(u.ProfileUsecase)
+
+	var wg sync.WaitGroup
+
+	if cfg.Server.MetricsListenAddress != "" {
+		wg.Go(func() {
+			if err := startMetricsServer(ctx, cfg.Server.MetricsListenAddress); err != nil {
+				panic(err)
+			}
+		})
+	}
+
+	if cfg.Server.HTTPListenAddress != "" {
+		wg.Go(func() {
+			if err := startHTTPServer(ctx, cfg.Server.HTTPListenAddress, handler.NewGateway(frontendHandler, handler.NewWebSocket(u.TicketUsecase, u.AssignUsecase, cfg.Server.WebSocketOriginPatterns, logger), logger)); err != nil {
+				panic(err)
+			}
+		})
+	}
+
+	if cfg.Match.WatchesProfiles() {
+		go watchMatchProfiles(ctx, logger, cfg.Match.Profiles, u.ProfileUsecase)
+	}
+
+	if !opts.DisableMatchLoop {
+		wg.Go(func() {
+			startMatchLoop(ctx, logger, u.MatchUsecase, cfg.Match.TickInterval)
+		})
+	}
+
+	if err := startServer(ctx, cfg.Server.ListenAddress, logger, frontendHandler, backendHandler, adminHandler); err != nil {
 		panic(err)
 	}
+
+	wg.Wait()
+	logger.Info("stopped")
 }
 
-func startFrontEndServer(frontendHandler *handler.Frontend) error {
-	listener, err := getListener()
+func startServer(ctx context.Context, address string, logger *slog.Logger, frontendHandler *handler.Frontend, backendHandler *handler.Backend, adminHandler *handler.Admin) error {
+	listener, err := getListener(address)
 	if err != nil {
 		return err
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(handler.NewUnaryLoggingInterceptor(logger)),
+		grpc.ChainStreamInterceptor(handler.NewStreamLoggingInterceptor(logger)),
+	)
 
 	pb.RegisterFrontendServiceServer(grpcServer, frontendHandler)
+	pb.RegisterBackendServiceServer(grpcServer, backendHandler)
+	pb.RegisterAdminServiceServer(grpcServer, adminHandler)
+
+	go func() {
+		<-ctx.Done()
+
+		// The watches last until the assignments, so they are closed if they keep the graceful stop waiting.
+		timer := time.AfterFunc(shutdownTimeout, grpcServer.Stop)
+		defer timer.Stop()
+
+		grpcServer.GracefulStop()
+	}()
 
 	if err := grpcServer.Serve(listener); err != nil {
 		return err
@@ -74,19 +204,78 @@ func startFrontEndServer(frontendHandler *handler.Frontend) error {
 	return nil
 }
 
-func startMatchLoop(ctx context.Context, matchUsecase usecase.MatchUsecase) error {
-	ticker := time.NewTicker(1 * time.Second)
+func startHTTPServer(ctx context.Context, address string, gateway *handler.Gateway) error {
+	return serveHTTP(ctx, address, gateway)
+}
+
+func startMetricsServer(ctx context.Context, address string) error {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metrics.Handler())
+
+	return serveHTTP(ctx, address, mux)
+}
+
+// serveHTTP serves the handler until ctx is canceled, and then waits for the requests in progress.
+// The WebSocket connections are not waited for, as they are taken over from the server.
+func serveHTTP(ctx context.Context, address string, h http.Handler) error {
+	listener, err := getListener(address)
+	if err != nil {
+		return err
+	}
+
+	httpServer := &http.Server{
+		Handler:           h,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		_ = httpServer.Shutdown(shutdownCtx)
+	}()
+
+	if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// watchMatchProfiles reloads the match profiles when the files are changed.
+// The current profiles are kept while the files are invalid.
+func watchMatchProfiles(ctx context.Context, logger *slog.Logger, path string, profileUsecase usecase.ProfileUsecase) {
+	err := config.WatchMatchProfiles(ctx, path, func() {
+		if err := profileUsecase.ReloadMatchProfiles(ctx); err != nil {
+			logger.ErrorContext(ctx, "failed to reload match profiles", slog.String("path", path), logging.Error(err))
+			return
+		}
+
+		logger.InfoContext(ctx, "reloaded match profiles", slog.String("path", path))
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "stopped watching match profiles", slog.String("path", path), logging.Error(err))
+	}
+}
+
+// startMatchLoop runs the match loop until ctx is canceled.
+func startMatchLoop(ctx context.Context, logger *slog.Logger, matchUsecase usecase.MatchUsecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
+			return
 		case <-ticker.C:
 			// The processing tick is not interrupted even if the context is canceled.
 			// However, the next tick will not be executed, which is a graceful shutdown process.
-			if err := matchUsecase.Exec(context.Background(), nil, nil); err != nil {
-				fmt.Printf("failed to exec match usecase: %+v", err)
+			// The logs of the tick are correlated by the tick ID.
+			tickCtx := logging.NewContext(context.Background(), slog.String("tick_id", xid.New().String()))
+			if err := matchUsecase.Exec(tickCtx, nil, nil); err != nil {
+				logger.ErrorContext(tickCtx, "failed to exec match usecase", logging.Error(err))
 			}
 		}
 
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/HMasataka/collision/gen/pb"
+	"github.com/bojand/hri"
+	"github.com/jessevdk/go-flags"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Options struct {
+	Host     string        `short:"h" long:"host" description:"Server host" default:"127.0.0.1"`
+	Port     string        `short:"p" long:"port" description:"Server port" default:"31080"`
+	Profile  string        `long:"profile" description:"Match profile name" default:"simple-1vs1"`
+	Pool     string        `long:"pool" description:"Pool name" default:"test-pool"`
+	Interval time.Duration `short:"i" long:"interval" description:"Fetch interval" default:"1s"`
+}
+
+func getConnection(host, port string) (*grpc.ClientConn, error) {
+	address := fmt.Sprintf("%s:%s", host, port)
+
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Println("Connecting to", address)
+
+	return conn, nil
+}
+
+func fetchMatches(ctx context.Context, client pb.BackendServiceClient, profile *pb.MatchProfile) ([]*pb.Match, error) {
+	stream, err := client.FetchMatches(ctx, &pb.FetchMatchesRequest{
+		Profile: profile,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*pb.Match
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return matches, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		matches = append(matches, response.GetMatch())
+	}
+}
+
+func assignTickets(ctx context.Context, client pb.BackendServiceClient, matches []*pb.Match) error {
+	asgs := make([]*pb.AssignmentGroup, 0, len(matches))
+
+	for _, match := range matches {
+		// The tickets matched into a backfill are assigned when the game server acknowledges the backfill.
+		if match.GetBackfill() != nil {
+			fmt.Printf("Match %s fills backfill %s\n", match.GetMatchId(), match.GetBackfill().GetId())
+			continue
+		}
+
+		ticketIDs := make([]string, 0, len(match.GetTickets()))
+		for _, ticket := range match.GetTickets() {
+			ticketIDs = append(ticketIDs, ticket.GetId())
+		}
+
+		// Allocate a game server here. This example just generates a random connection name.
+		conn := hri.Random()
+		fmt.Printf("Assign '%s' to match %s\n", conn, match.GetMatchId())
+
+		asgs = append(asgs, &pb.AssignmentGroup{
+			TicketIds:  ticketIDs,
+			Assignment: &pb.Assignment{Connection: conn},
+		})
+	}
+	if len(asgs) == 0 {
+		return nil
+	}
+
+	response, err := client.AssignTickets(ctx, &pb.AssignTicketsRequest{
+		Assignments: asgs,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, ticketID := range response.GetFailedTicketIds() {
+		log.Printf("Failed to assign ticket %s", ticketID)
+	}
+
+	return nil
+}
+
+func main() {
+	var opts Options
+	parser := flags.NewParser(&opts, flags.Default)
+	if _, err := parser.Parse(); err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(1)
+	}
+
+	conn, err := getConnection(opts.Host, opts.Port)
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client := pb.NewBackendServiceClient(conn)
+
+	profile := &pb.MatchProfile{
+		Name: opts.Profile,
+		Pools: []*pb.Pool{
+			{Name: opts.Pool},
+		},
+	}
+
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			matches, err := fetchMatches(ctx, client, profile)
+			if err != nil {
+				log.Printf("Failed to fetch matches: %v", err)
+				continue
+			}
+
+			if len(matches) == 0 {
+				continue
+			}
+
+			if err := assignTickets(ctx, client, matches); err != nil {
+				log.Printf("Failed to assign tickets: %v", err)
+			}
+		}
+	}
+}
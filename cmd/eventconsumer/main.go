@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/infrastructure/driver"
+	"github.com/jessevdk/go-flags"
+	"github.com/redis/rueidis"
+)
+
+type Options struct {
+	Address  string        `short:"a" long:"address" description:"Redis address" default:"127.0.0.1:6379"`
+	Password string        `long:"password" description:"Redis password"`
+	Stream   string        `long:"stream" description:"Stream of the ticket events" default:"collision:ticketEvents"`
+	Group    string        `long:"group" description:"Consumer group, which is created at the end of the stream if it does not exist" default:"eventconsumer"`
+	Consumer string        `long:"consumer" description:"Consumer name in the group" default:"eventconsumer-1"`
+	Count    int64         `long:"count" description:"Maximum number of events read at once" default:"100"`
+	Block    time.Duration `long:"block" description:"How long each read waits for new events" default:"5s"`
+}
+
+// A consumer of the ticket lifecycle events that the server appends to a Redis Stream with events.sink: redis.
+// It reads the events in a consumer group, prints them and acknowledges them.
+// The events delivered but not acknowledged before a restart are read again first.
+func main() {
+	var opts Options
+	parser := flags.NewParser(&opts, flags.Default)
+	if _, err := parser.Parse(); err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(1)
+	}
+
+	client, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:  []string{opts.Address},
+		Password:     opts.Password,
+		DisableCache: true,
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to Redis: %v", err)
+	}
+	defer client.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := createGroup(ctx, client, opts.Stream, opts.Group); err != nil {
+		log.Fatalf("Failed to create consumer group: %v", err)
+	}
+
+	fmt.Printf("Consuming %s as %s in group %s\n", opts.Stream, opts.Consumer, opts.Group)
+
+	// "0" reads the pending events of this consumer, and ">" reads the new events once they are done.
+	id := "0"
+	for ctx.Err() == nil {
+		entries, err := read(ctx, client, opts, id)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			log.Printf("Failed to read events: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+		if id == "0" && len(entries) == 0 {
+			id = ">"
+			continue
+		}
+
+		for _, entry := range entries {
+			handle(entry)
+
+			ack := client.B().Xack().Key(opts.Stream).Group(opts.Group).Id(entry.ID).Build()
+			if err := client.Do(ctx, ack).Error(); err != nil {
+				log.Printf("Failed to acknowledge event %s: %v", entry.ID, err)
+			}
+		}
+	}
+
+	fmt.Println("Stopped")
+}
+
+func createGroup(ctx context.Context, client rueidis.Client, stream, group string) error {
+	query := client.B().XgroupCreate().Key(stream).Group(group).Id("$").Mkstream().Build()
+
+	if err := client.Do(ctx, query).Error(); err != nil && !rueidis.IsRedisBusyGroup(err) {
+		return err
+	}
+
+	return nil
+}
+
+func read(ctx context.Context, client rueidis.Client, opts Options, id string) ([]rueidis.XRangeEntry, error) {
+	query := client.B().Xreadgroup().Group(opts.Group, opts.Consumer).Count(opts.Count).Block(opts.Block.Milliseconds()).
+		Streams().Key(opts.Stream).Id(id).Build()
+
+	streams, err := client.Do(ctx, query).AsXRead()
+	if rueidis.IsRedisNil(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return streams[opts.Stream], nil
+}
+
+func handle(entry rueidis.XRangeEntry) {
+	var event entity.TicketEvent
+	if err := json.Unmarshal([]byte(entry.FieldValues[driver.EventStreamField]), &event); err != nil {
+		log.Printf("Skipping malformed event %s: %v", entry.ID, err)
+		return
+	}
+
+	line := fmt.Sprintf("%s %-8s ticket=%s", event.Time.Format(time.RFC3339Nano), event.Type, event.TicketID)
+	if event.MatchID != "" {
+		line += fmt.Sprintf(" match=%s profile=%s", event.MatchID, event.MatchProfile)
+	}
+	if event.Assignment != nil {
+		line += fmt.Sprintf(" connection=%s", event.Assignment.Connection)
+	}
+
+	fmt.Println(line)
+}
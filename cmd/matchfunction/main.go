@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"net"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/HMasataka/collision/gen/pb"
+	"github.com/HMasataka/collision/handler"
+	"github.com/HMasataka/collision/usecase"
+	"github.com/jessevdk/go-flags"
+	"google.golang.org/grpc"
+)
+
+type Options struct {
+	Address  string `short:"a" long:"address" description:"Listen address" default:"127.0.0.1:50502"`
+	Function string `short:"f" long:"function" description:"Name of the built-in match function to serve" default:"simple-1vs1"`
+}
+
+// An example of a match function served out of the server.
+// Register it in match.functions of the server config and refer to it from the match profiles.
+func main() {
+	var opts Options
+	parser := flags.NewParser(&opts, flags.Default)
+	if _, err := parser.Parse(); err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(1)
+	}
+
+	matchFunctions := usecase.MatchFunctions()
+	mmf, ok := matchFunctions[opts.Function]
+	if !ok {
+		names := slices.Sorted(maps.Keys(matchFunctions))
+		fmt.Fprintf(os.Stderr, "unknown match function %q: must be one of %s\n", opts.Function, strings.Join(names, ", "))
+		os.Exit(1)
+	}
+
+	listener, err := net.Listen("tcp", opts.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Serving %s on %s\n", opts.Function, opts.Address)
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterMatchFunctionServiceServer(grpcServer, handler.NewMatchFunction(mmf))
+
+	if err := grpcServer.Serve(listener); err != nil {
+		panic(err)
+	}
+}
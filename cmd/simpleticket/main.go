@@ -50,7 +50,7 @@ func createTicket(ctx context.Context, client pb.FrontendServiceClient, playerID
 		Extensions:   fmt.Appendf(nil, `{"player_id": "%s"}`, playerID),
 	})
 	if err != nil {
-		return "", entity.ErrTicketCreateFailed.WithCause(err)
+		return "", entity.WithCause(entity.ErrTicketCreateFailed, err)
 	}
 
 	fmt.Printf("Created ticket %s for player %s\n", response.Id, playerID)
@@ -62,6 +62,7 @@ func watchAssignments(ctx context.Context, client pb.FrontendServiceClient, tick
 
 	stream, err := client.WatchAssignments(ctx, &pb.WatchAssignmentsRequest{
 		TicketId: ticketID,
+		OneShot:  true,
 	})
 	if err != nil {
 		log.Printf("Failed to watch assignments for ticket %s: %v", ticketID, err)
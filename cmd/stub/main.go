@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+
+	"github.com/HMasataka/collision/gen/pb"
+	"github.com/HMasataka/collision/handler"
+	"github.com/HMasataka/collision/usecase"
+	"github.com/jessevdk/go-flags"
+	"google.golang.org/grpc"
+)
+
+type Options struct {
+	Address string `short:"a" long:"address" description:"Listen address" default:"127.0.0.1:50503"`
+}
+
+// A stub of the evaluator and the assigner served out of the server, backed by the built-in ones.
+// Set its address to match.evaluator and match.assigner of the server config to try or test the remote calls.
+func main() {
+	var opts Options
+	parser := flags.NewParser(&opts, flags.Default)
+	if _, err := parser.Parse(); err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(1)
+	}
+
+	listener, err := net.Listen("tcp", opts.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Serving the evaluator and the assigner on %s\n", opts.Address)
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterEvaluatorServiceServer(grpcServer, handler.NewEvaluator(usecase.NewDefaultEvaluator()))
+	pb.RegisterAssignerServiceServer(grpcServer, handler.NewAssigner(usecase.NewRandomAssigner(slog.Default())))
+
+	if err := grpcServer.Serve(listener); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,525 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/errs"
+	"gopkg.in/yaml.v3"
+)
+
+const envPrefix = "COLLISION_"
+
+// TicketIndexMode selects how the workers update the ticket index without fetching the same ticket twice.
+type TicketIndexMode string
+
+const (
+	// TicketIndexScript updates the ticket index atomically with Lua scripts.
+	TicketIndexScript TicketIndexMode = "script"
+	// TicketIndexLock serializes the updates of the ticket index with the fetch lock.
+	TicketIndexLock TicketIndexMode = "lock"
+)
+
+// TracingExporter selects where the spans are exported.
+type TracingExporter string
+
+const (
+	// TracingExporterNone disables the tracing.
+	TracingExporterNone TracingExporter = ""
+	// TracingExporterOTLP sends the spans to an OTLP collector over gRPC.
+	TracingExporterOTLP TracingExporter = "otlp"
+	// TracingExporterStdout writes the spans to the standard output.
+	TracingExporterStdout TracingExporter = "stdout"
+)
+
+// EventSink selects where the ticket lifecycle events are emitted.
+type EventSink string
+
+const (
+	// EventSinkNone disables the ticket lifecycle events.
+	EventSinkNone EventSink = ""
+	// EventSinkRedis appends the events to a Redis Stream.
+	EventSinkRedis EventSink = "redis"
+	// EventSinkFile appends the events to a file as JSON lines.
+	EventSinkFile EventSink = "file"
+	// EventSinkStdout writes the events to the standard output as JSON lines.
+	EventSinkStdout EventSink = "stdout"
+)
+
+// LogFormat selects the format of the log records.
+type LogFormat string
+
+const (
+	LogFormatJSON LogFormat = "json"
+	LogFormatText LogFormat = "text"
+)
+
+type Config struct {
+	Server   ServerConfig   `yaml:"server"`
+	Redis    RedisConfig    `yaml:"redis"`
+	Ticket   TicketConfig   `yaml:"ticket"`
+	Backfill BackfillConfig `yaml:"backfill"`
+	Match    MatchConfig    `yaml:"match"`
+	Tracing  TracingConfig  `yaml:"tracing"`
+	Log      LogConfig      `yaml:"log"`
+	Events   EventsConfig   `yaml:"events"`
+}
+
+type ServerConfig struct {
+	ListenAddress string `yaml:"listenAddress"`
+	// HTTPListenAddress is the address of the HTTP/JSON gateway of the FrontendService.
+	// The gateway is disabled if it is empty.
+	HTTPListenAddress string `yaml:"httpListenAddress"`
+	// MetricsListenAddress is the address serving the Prometheus metrics on /metrics.
+	// The metrics are not served if it is empty.
+	MetricsListenAddress string `yaml:"metricsListenAddress"`
+	// WebSocketOriginPatterns are the host patterns of the origins allowed to open the WebSocket from another origin,
+	// such as "example.com" or "*.example.com". The same origin as the host is always allowed.
+	WebSocketOriginPatterns []string `yaml:"webSocketOriginPatterns"`
+}
+
+type RedisConfig struct {
+	Address  string `yaml:"address"`
+	Password string `yaml:"password"`
+	// LockTTL is the validity of the fetch lock. The lock is extended while it is held.
+	LockTTL     time.Duration   `yaml:"lockTTL"`
+	TicketIndex TicketIndexMode `yaml:"ticketIndex"`
+}
+
+type TicketConfig struct {
+	// TTL is how long a ticket is kept until it is assigned.
+	TTL time.Duration `yaml:"ttl"`
+	// AssignedTTL is how long an assigned ticket and its assignment are kept.
+	AssignedTTL time.Duration `yaml:"assignedTTL"`
+	// PendingReleaseTimeout is how long a fetched ticket is excluded from the other fetches
+	// when it is neither assigned nor released.
+	PendingReleaseTimeout time.Duration `yaml:"pendingReleaseTimeout"`
+}
+
+type BackfillConfig struct {
+	// TTL is how long a backfill is kept after it is created, updated or acknowledged.
+	// The game server keeps the backfill by acknowledging it periodically.
+	TTL time.Duration `yaml:"ttl"`
+}
+
+type LogConfig struct {
+	// Level is the minimum level of the records: debug, info, warn or error.
+	Level  string    `yaml:"level"`
+	Format LogFormat `yaml:"format"`
+}
+
+// SlogLevel returns the level. It is info if the level is invalid, which Validate reports.
+func (c LogConfig) SlogLevel() slog.Level {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Level)); err != nil {
+		return slog.LevelInfo
+	}
+
+	return level
+}
+
+type EventsConfig struct {
+	Sink EventSink `yaml:"sink"`
+	// Stream is the key of the Redis Stream for the redis sink.
+	Stream string `yaml:"stream"`
+	// MaxLen is the approximate number of the events kept in the stream. The stream is not trimmed if it is 0.
+	// A consumer lagging behind by more than MaxLen events loses the oldest of them,
+	// while a larger MaxLen keeps more memory in Redis.
+	MaxLen int64 `yaml:"maxLen"`
+	// Path is the file for the file sink.
+	Path string `yaml:"path"`
+}
+
+type TracingConfig struct {
+	Exporter TracingExporter `yaml:"exporter"`
+	// Endpoint is the address of the OTLP collector such as 127.0.0.1:4317.
+	// If it is empty, OTEL_EXPORTER_OTLP_ENDPOINT or localhost:4317 is used.
+	Endpoint string `yaml:"endpoint"`
+	// Insecure connects to the OTLP collector without TLS.
+	Insecure bool `yaml:"insecure"`
+	// SampleRatio is the ratio of the traces started by the server that are sampled.
+	// The traces started by the clients follow their sampling decision.
+	SampleRatio float64 `yaml:"sampleRatio"`
+}
+
+type MatchConfig struct {
+	// FetchLimit is the maximum number of tickets fetched for a match.
+	FetchLimit int64 `yaml:"fetchLimit"`
+	// TickInterval is the interval of the internal match loop.
+	TickInterval time.Duration `yaml:"tickInterval"`
+	// Profiles is the match profiles file or the directory containing them.
+	// The built-in simple-1vs1 profile is used if it is empty.
+	Profiles string `yaml:"profiles"`
+	// WatchProfiles reloads the match profiles when the files are changed.
+	WatchProfiles bool `yaml:"watchProfiles"`
+	// Functions is the match functions served out of the server, which the profiles can refer to by name.
+	Functions []*RemoteMatchFunctionConfig `yaml:"functions"`
+	// Evaluator is the EvaluatorService. The built-in evaluator is used if it is not set.
+	Evaluator *RemoteConfig `yaml:"evaluator"`
+	// Assigner is the AssignerService. The built-in random assigner is used if it is not set.
+	Assigner *RemoteConfig `yaml:"assigner"`
+}
+
+// WatchesProfiles reports whether the match profiles are reloaded from the files when they are changed.
+func (c MatchConfig) WatchesProfiles() bool {
+	return c.Profiles != "" && c.WatchProfiles
+}
+
+// RemoteConfig is the service called by the server over gRPC.
+type RemoteConfig struct {
+	// Address is the address of the service.
+	Address string `yaml:"address"`
+	// Timeout is the deadline of each call. It defaults to 5s.
+	Timeout time.Duration `yaml:"timeout"`
+	// MaxRetries is the number of retries after a call fails with a temporary error. It defaults to 2.
+	MaxRetries *uint64 `yaml:"maxRetries"`
+}
+
+type RemoteMatchFunctionConfig struct {
+	Name         string `yaml:"name"`
+	RemoteConfig `yaml:",inline"`
+}
+
+const (
+	defaultRemoteTimeout    = 5 * time.Second
+	defaultRemoteMaxRetries = 2
+)
+
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			ListenAddress: "127.0.0.1:31080",
+		},
+		Redis: RedisConfig{
+			Address:     "127.0.0.1:6379",
+			LockTTL:     1 * time.Second,
+			TicketIndex: TicketIndexScript,
+		},
+		Ticket: TicketConfig{
+			TTL:                   10 * time.Minute,
+			AssignedTTL:           1 * time.Minute,
+			PendingReleaseTimeout: 1 * time.Minute,
+		},
+		Backfill: BackfillConfig{
+			TTL: 1 * time.Minute,
+		},
+		Tracing: TracingConfig{
+			Insecure:    true,
+			SampleRatio: 1,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: LogFormatJSON,
+		},
+		Events: EventsConfig{
+			Stream: "collision:ticketEvents",
+			MaxLen: 100000,
+		},
+		Match: MatchConfig{
+			FetchLimit:    10000,
+			TickInterval:  1 * time.Second,
+			WatchProfiles: true,
+		},
+	}
+}
+
+// MatchProfiles loads the match profiles configured in Match.Profiles.
+func (c *Config) MatchProfiles() ([]*MatchProfileConfig, *errs.Error) {
+	if c.Match.Profiles == "" {
+		return DefaultMatchProfiles(), nil
+	}
+
+	return LoadMatchProfiles(c.Match.Profiles)
+}
+
+// Load reads the config from the YAML file over the defaults, then applies the environment variables.
+// The file is optional, and only the defaults and the environment variables are used if path is empty.
+func Load(path string) (*Config, *errs.Error) {
+	cfg := Default()
+
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, entity.WithCause(entity.ErrConfigLoadFailed, err)
+		}
+		defer f.Close()
+
+		decoder := yaml.NewDecoder(f)
+		// Misspelled keys would otherwise be ignored silently.
+		decoder.KnownFields(true)
+		if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+			return nil, entity.WithCause(entity.ErrConfigLoadFailed, err)
+		}
+	}
+
+	if err := cfg.applyEnv(os.LookupEnv); err != nil {
+		return nil, entity.WithCause(entity.ErrConfigLoadFailed, err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	for _, f := range cfg.Match.Functions {
+		f.RemoteConfig.setDefaults()
+	}
+	if cfg.Match.Evaluator != nil {
+		cfg.Match.Evaluator.setDefaults()
+	}
+	if cfg.Match.Assigner != nil {
+		cfg.Match.Assigner.setDefaults()
+	}
+
+	return cfg, nil
+}
+
+// applyEnv overrides the config with the environment variables such as COLLISION_REDIS_ADDRESS.
+func (c *Config) applyEnv(lookup func(string) (string, bool)) error {
+	var problems []error
+
+	envString(lookup, "SERVER_LISTEN_ADDRESS", &c.Server.ListenAddress)
+	envString(lookup, "SERVER_HTTP_LISTEN_ADDRESS", &c.Server.HTTPListenAddress)
+	envString(lookup, "SERVER_METRICS_LISTEN_ADDRESS", &c.Server.MetricsListenAddress)
+	envStrings(lookup, "SERVER_WEBSOCKET_ORIGIN_PATTERNS", &c.Server.WebSocketOriginPatterns)
+	envString(lookup, "REDIS_ADDRESS", &c.Redis.Address)
+	envString(lookup, "REDIS_PASSWORD", &c.Redis.Password)
+	problems = append(problems, envDuration(lookup, "REDIS_LOCK_TTL", &c.Redis.LockTTL))
+	envString(lookup, "REDIS_TICKET_INDEX", (*string)(&c.Redis.TicketIndex))
+	problems = append(problems, envDuration(lookup, "TICKET_TTL", &c.Ticket.TTL))
+	problems = append(problems, envDuration(lookup, "TICKET_ASSIGNED_TTL", &c.Ticket.AssignedTTL))
+	problems = append(problems, envDuration(lookup, "TICKET_PENDING_RELEASE_TIMEOUT", &c.Ticket.PendingReleaseTimeout))
+	problems = append(problems, envDuration(lookup, "BACKFILL_TTL", &c.Backfill.TTL))
+	problems = append(problems, envInt(lookup, "MATCH_FETCH_LIMIT", &c.Match.FetchLimit))
+	problems = append(problems, envDuration(lookup, "MATCH_TICK_INTERVAL", &c.Match.TickInterval))
+	envString(lookup, "MATCH_PROFILES", &c.Match.Profiles)
+	problems = append(problems, envBool(lookup, "MATCH_WATCH_PROFILES", &c.Match.WatchProfiles))
+	envString(lookup, "TRACING_EXPORTER", (*string)(&c.Tracing.Exporter))
+	envString(lookup, "TRACING_ENDPOINT", &c.Tracing.Endpoint)
+	problems = append(problems, envBool(lookup, "TRACING_INSECURE", &c.Tracing.Insecure))
+	problems = append(problems, envFloat(lookup, "TRACING_SAMPLE_RATIO", &c.Tracing.SampleRatio))
+	envString(lookup, "LOG_LEVEL", &c.Log.Level)
+	envString(lookup, "LOG_FORMAT", (*string)(&c.Log.Format))
+	envString(lookup, "EVENTS_SINK", (*string)(&c.Events.Sink))
+	envString(lookup, "EVENTS_STREAM", &c.Events.Stream)
+	problems = append(problems, envInt(lookup, "EVENTS_MAX_LEN", &c.Events.MaxLen))
+	envString(lookup, "EVENTS_PATH", &c.Events.Path)
+
+	return errors.Join(problems...)
+}
+
+func envString(lookup func(string) (string, bool), name string, dst *string) {
+	if v, ok := lookup(envPrefix + name); ok {
+		*dst = v
+	}
+}
+
+// envStrings sets the comma separated values. An empty value clears the list.
+func envStrings(lookup func(string) (string, bool), name string, dst *[]string) {
+	v, ok := lookup(envPrefix + name)
+	if !ok {
+		return
+	}
+
+	var values []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			values = append(values, s)
+		}
+	}
+
+	*dst = values
+}
+
+func envDuration(lookup func(string) (string, bool), name string, dst *time.Duration) error {
+	v, ok := lookup(envPrefix + name)
+	if !ok {
+		return nil
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return fmt.Errorf("%s%s: %w", envPrefix, name, err)
+	}
+
+	*dst = d
+	return nil
+}
+
+func envInt(lookup func(string) (string, bool), name string, dst *int64) error {
+	v, ok := lookup(envPrefix + name)
+	if !ok {
+		return nil
+	}
+
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%s%s: %w", envPrefix, name, err)
+	}
+
+	*dst = n
+	return nil
+}
+
+func envBool(lookup func(string) (string, bool), name string, dst *bool) error {
+	v, ok := lookup(envPrefix + name)
+	if !ok {
+		return nil
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return fmt.Errorf("%s%s: %w", envPrefix, name, err)
+	}
+
+	*dst = b
+	return nil
+}
+
+func envFloat(lookup func(string) (string, bool), name string, dst *float64) error {
+	v, ok := lookup(envPrefix + name)
+	if !ok {
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return fmt.Errorf("%s%s: %w", envPrefix, name, err)
+	}
+
+	*dst = f
+	return nil
+}
+
+func (c *Config) Validate() *errs.Error {
+	var problems []error
+
+	if c.Server.ListenAddress == "" {
+		problems = append(problems, errors.New("server.listenAddress is required"))
+	}
+	for _, pattern := range c.Server.WebSocketOriginPatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			problems = append(problems, fmt.Errorf("server.webSocketOriginPatterns: %q: %w", pattern, err))
+		}
+	}
+	if c.Redis.Address == "" {
+		problems = append(problems, errors.New("redis.address is required"))
+	}
+	if c.Redis.LockTTL <= 0 {
+		problems = append(problems, errors.New("redis.lockTTL must be positive"))
+	}
+	switch c.Redis.TicketIndex {
+	case TicketIndexScript, TicketIndexLock:
+	default:
+		problems = append(problems, fmt.Errorf("redis.ticketIndex must be %q or %q", TicketIndexScript, TicketIndexLock))
+	}
+	// Redis expiration has a resolution of one second.
+	if c.Ticket.TTL < time.Second {
+		problems = append(problems, errors.New("ticket.ttl must be at least 1s"))
+	}
+	if c.Ticket.AssignedTTL < time.Second {
+		problems = append(problems, errors.New("ticket.assignedTTL must be at least 1s"))
+	}
+	if c.Ticket.PendingReleaseTimeout < time.Second {
+		problems = append(problems, errors.New("ticket.pendingReleaseTimeout must be at least 1s"))
+	}
+	if c.Backfill.TTL < time.Second {
+		problems = append(problems, errors.New("backfill.ttl must be at least 1s"))
+	}
+	if c.Match.FetchLimit <= 0 {
+		problems = append(problems, errors.New("match.fetchLimit must be positive"))
+	}
+	if c.Match.TickInterval <= 0 {
+		problems = append(problems, errors.New("match.tickInterval must be positive"))
+	}
+	names := map[string]struct{}{}
+	for i, f := range c.Match.Functions {
+		if f == nil {
+			problems = append(problems, fmt.Errorf("match.functions[%d] is empty", i))
+			continue
+		}
+		if f.Name == "" {
+			problems = append(problems, fmt.Errorf("match.functions[%d].name is required", i))
+		}
+		if _, ok := names[f.Name]; ok && f.Name != "" {
+			problems = append(problems, fmt.Errorf("match.functions[%d].name %q is duplicated", i, f.Name))
+		}
+		names[f.Name] = struct{}{}
+		problems = append(problems, f.RemoteConfig.validate(fmt.Sprintf("match.functions[%d]", i))...)
+	}
+	switch c.Tracing.Exporter {
+	case TracingExporterNone, TracingExporterOTLP, TracingExporterStdout:
+	default:
+		problems = append(problems, fmt.Errorf("tracing.exporter must be empty, %q or %q", TracingExporterOTLP, TracingExporterStdout))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		problems = append(problems, errors.New("tracing.sampleRatio must be between 0 and 1"))
+	}
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Log.Level)); err != nil {
+		problems = append(problems, errors.New("log.level must be debug, info, warn or error"))
+	}
+	switch c.Log.Format {
+	case LogFormatJSON, LogFormatText:
+	default:
+		problems = append(problems, fmt.Errorf("log.format must be %q or %q", LogFormatJSON, LogFormatText))
+	}
+	switch c.Events.Sink {
+	case EventSinkNone, EventSinkStdout:
+	case EventSinkRedis:
+		if c.Events.Stream == "" {
+			problems = append(problems, errors.New("events.stream is required for the redis sink"))
+		}
+	case EventSinkFile:
+		if c.Events.Path == "" {
+			problems = append(problems, errors.New("events.path is required for the file sink"))
+		}
+	default:
+		problems = append(problems, fmt.Errorf("events.sink must be empty, %q, %q or %q", EventSinkRedis, EventSinkFile, EventSinkStdout))
+	}
+	if c.Events.MaxLen < 0 {
+		problems = append(problems, errors.New("events.maxLen must not be negative"))
+	}
+	if c.Match.Evaluator != nil {
+		problems = append(problems, c.Match.Evaluator.validate("match.evaluator")...)
+	}
+	if c.Match.Assigner != nil {
+		problems = append(problems, c.Match.Assigner.validate("match.assigner")...)
+	}
+
+	if len(problems) > 0 {
+		return entity.WithCause(entity.ErrConfigInvalid, errors.Join(problems...))
+	}
+
+	return nil
+}
+
+func (r *RemoteConfig) validate(path string) []error {
+	var problems []error
+
+	if r.Address == "" {
+		problems = append(problems, fmt.Errorf("%s.address is required", path))
+	}
+	if r.Timeout < 0 {
+		problems = append(problems, fmt.Errorf("%s.timeout must not be negative", path))
+	}
+
+	return problems
+}
+
+func (r *RemoteConfig) setDefaults() {
+	if r.Timeout == 0 {
+		r.Timeout = defaultRemoteTimeout
+	}
+	if r.MaxRetries == nil {
+		maxRetries := uint64(defaultRemoteMaxRetries)
+		r.MaxRetries = &maxRetries
+	}
+}
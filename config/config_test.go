@@ -0,0 +1,338 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+)
+
+// writeConfig writes the YAML config to a file in a temporary directory and returns its path.
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		env     map[string]string
+		check   func(t *testing.T, cfg *Config)
+		wantErr error
+	}{
+		{
+			name: "defaults without the file",
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Server.ListenAddress != Default().Server.ListenAddress {
+					t.Errorf("Server.ListenAddress = %q, want the default", cfg.Server.ListenAddress)
+				}
+				if cfg.Ticket.TTL != 10*time.Minute {
+					t.Errorf("Ticket.TTL = %v, want 10m", cfg.Ticket.TTL)
+				}
+			},
+		},
+		{
+			name: "file over the defaults",
+			yaml: `
+server:
+  listenAddress: 0.0.0.0:9000
+ticket:
+  ttl: 5m
+match:
+  evaluator:
+    address: 127.0.0.1:50502
+`,
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Server.ListenAddress != "0.0.0.0:9000" {
+					t.Errorf("Server.ListenAddress = %q, want 0.0.0.0:9000", cfg.Server.ListenAddress)
+				}
+				if cfg.Ticket.TTL != 5*time.Minute {
+					t.Errorf("Ticket.TTL = %v, want 5m", cfg.Ticket.TTL)
+				}
+				// The keys not in the file keep the defaults.
+				if cfg.Ticket.AssignedTTL != time.Minute {
+					t.Errorf("Ticket.AssignedTTL = %v, want 1m", cfg.Ticket.AssignedTTL)
+				}
+				if cfg.Match.Evaluator.Timeout != defaultRemoteTimeout || *cfg.Match.Evaluator.MaxRetries != defaultRemoteMaxRetries {
+					t.Errorf("Match.Evaluator = %+v, want the default timeout and retries", cfg.Match.Evaluator)
+				}
+			},
+		},
+		{
+			name: "environment over the file",
+			yaml: `
+server:
+  listenAddress: 0.0.0.0:9000
+redis:
+  lockTTL: 2s
+match:
+  watchProfiles: true
+`,
+			env: map[string]string{
+				"COLLISION_SERVER_LISTEN_ADDRESS":            "0.0.0.0:9001",
+				"COLLISION_REDIS_LOCK_TTL":                   "3s",
+				"COLLISION_MATCH_WATCH_PROFILES":             "false",
+				"COLLISION_MATCH_FETCH_LIMIT":                "50",
+				"COLLISION_TRACING_SAMPLE_RATIO":             "0.5",
+				"COLLISION_SERVER_WEBSOCKET_ORIGIN_PATTERNS": "example.com, *.example.com,",
+			},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Server.ListenAddress != "0.0.0.0:9001" {
+					t.Errorf("Server.ListenAddress = %q, want 0.0.0.0:9001", cfg.Server.ListenAddress)
+				}
+				if cfg.Redis.LockTTL != 3*time.Second {
+					t.Errorf("Redis.LockTTL = %v, want 3s", cfg.Redis.LockTTL)
+				}
+				if cfg.Match.WatchProfiles {
+					t.Error("Match.WatchProfiles = true, want false")
+				}
+				if cfg.Match.FetchLimit != 50 {
+					t.Errorf("Match.FetchLimit = %d, want 50", cfg.Match.FetchLimit)
+				}
+				if cfg.Tracing.SampleRatio != 0.5 {
+					t.Errorf("Tracing.SampleRatio = %v, want 0.5", cfg.Tracing.SampleRatio)
+				}
+				if want := []string{"example.com", "*.example.com"}; !slices.Equal(cfg.Server.WebSocketOriginPatterns, want) {
+					t.Errorf("Server.WebSocketOriginPatterns = %q, want %q", cfg.Server.WebSocketOriginPatterns, want)
+				}
+			},
+		},
+		{
+			name:    "unknown key",
+			yaml:    "server:\n  listenAdress: 0.0.0.0:9000\n",
+			wantErr: entity.ErrConfigLoadFailed,
+		},
+		{
+			name:    "bad duration in the file",
+			yaml:    "ticket:\n  ttl: ten minutes\n",
+			wantErr: entity.ErrConfigLoadFailed,
+		},
+		{
+			name:    "bad duration in the environment",
+			env:     map[string]string{"COLLISION_TICKET_TTL": "ten minutes"},
+			wantErr: entity.ErrConfigLoadFailed,
+		},
+		{
+			name:    "bad bool in the environment",
+			env:     map[string]string{"COLLISION_MATCH_WATCH_PROFILES": "maybe"},
+			wantErr: entity.ErrConfigLoadFailed,
+		},
+		{
+			name:    "invalid after the environment",
+			yaml:    "redis:\n  address: 127.0.0.1:6379\n",
+			env:     map[string]string{"COLLISION_REDIS_ADDRESS": ""},
+			wantErr: entity.ErrConfigInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			path := ""
+			if tt.yaml != "" {
+				path = writeConfig(t, tt.yaml)
+			}
+
+			cfg, err := Load(path)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Load() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() error = %v: %v", err, err.Unwrap())
+			}
+
+			tt.check(t, cfg)
+		})
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.yaml")); !errors.Is(err, entity.ErrConfigLoadFailed) {
+		t.Errorf("Load() error = %v, want %v", err, entity.ErrConfigLoadFailed)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		problem string
+	}{
+		{
+			name:    "empty listen address",
+			modify:  func(cfg *Config) { cfg.Server.ListenAddress = "" },
+			problem: "server.listenAddress is required",
+		},
+		{
+			name:    "bad origin pattern",
+			modify:  func(cfg *Config) { cfg.Server.WebSocketOriginPatterns = []string{"["} },
+			problem: "server.webSocketOriginPatterns",
+		},
+		{
+			name:    "empty redis address",
+			modify:  func(cfg *Config) { cfg.Redis.Address = "" },
+			problem: "redis.address is required",
+		},
+		{
+			name:    "zero lock TTL",
+			modify:  func(cfg *Config) { cfg.Redis.LockTTL = 0 },
+			problem: "redis.lockTTL must be positive",
+		},
+		{
+			name:    "unknown ticket index",
+			modify:  func(cfg *Config) { cfg.Redis.TicketIndex = "queue" },
+			problem: "redis.ticketIndex must be",
+		},
+		{
+			name:    "ticket TTL under a second",
+			modify:  func(cfg *Config) { cfg.Ticket.TTL = 500 * time.Millisecond },
+			problem: "ticket.ttl must be at least 1s",
+		},
+		{
+			name:    "assigned TTL under a second",
+			modify:  func(cfg *Config) { cfg.Ticket.AssignedTTL = 0 },
+			problem: "ticket.assignedTTL must be at least 1s",
+		},
+		{
+			name:    "pending release timeout under a second",
+			modify:  func(cfg *Config) { cfg.Ticket.PendingReleaseTimeout = -time.Second },
+			problem: "ticket.pendingReleaseTimeout must be at least 1s",
+		},
+		{
+			name:    "backfill TTL under a second",
+			modify:  func(cfg *Config) { cfg.Backfill.TTL = 0 },
+			problem: "backfill.ttl must be at least 1s",
+		},
+		{
+			name:    "zero fetch limit",
+			modify:  func(cfg *Config) { cfg.Match.FetchLimit = 0 },
+			problem: "match.fetchLimit must be positive",
+		},
+		{
+			name:    "zero tick interval",
+			modify:  func(cfg *Config) { cfg.Match.TickInterval = 0 },
+			problem: "match.tickInterval must be positive",
+		},
+		{
+			name:    "empty match function",
+			modify:  func(cfg *Config) { cfg.Match.Functions = []*RemoteMatchFunctionConfig{nil} },
+			problem: "match.functions[0] is empty",
+		},
+		{
+			name: "match function without name",
+			modify: func(cfg *Config) {
+				cfg.Match.Functions = []*RemoteMatchFunctionConfig{{RemoteConfig: RemoteConfig{Address: "127.0.0.1:50502"}}}
+			},
+			problem: "match.functions[0].name is required",
+		},
+		{
+			name: "duplicated match function",
+			modify: func(cfg *Config) {
+				cfg.Match.Functions = []*RemoteMatchFunctionConfig{
+					{Name: "mmf", RemoteConfig: RemoteConfig{Address: "127.0.0.1:50502"}},
+					{Name: "mmf", RemoteConfig: RemoteConfig{Address: "127.0.0.1:50503"}},
+				}
+			},
+			problem: `match.functions[1].name "mmf" is duplicated`,
+		},
+		{
+			name: "match function without address",
+			modify: func(cfg *Config) {
+				cfg.Match.Functions = []*RemoteMatchFunctionConfig{{Name: "mmf"}}
+			},
+			problem: "match.functions[0].address is required",
+		},
+		{
+			name:    "evaluator without address",
+			modify:  func(cfg *Config) { cfg.Match.Evaluator = &RemoteConfig{} },
+			problem: "match.evaluator.address is required",
+		},
+		{
+			name: "negative assigner timeout",
+			modify: func(cfg *Config) {
+				cfg.Match.Assigner = &RemoteConfig{Address: "127.0.0.1:50504", Timeout: -time.Second}
+			},
+			problem: "match.assigner.timeout must not be negative",
+		},
+		{
+			name:    "unknown tracing exporter",
+			modify:  func(cfg *Config) { cfg.Tracing.Exporter = "jaeger" },
+			problem: "tracing.exporter must be",
+		},
+		{
+			name:    "sample ratio over 1",
+			modify:  func(cfg *Config) { cfg.Tracing.SampleRatio = 1.5 },
+			problem: "tracing.sampleRatio must be between 0 and 1",
+		},
+		{
+			name:    "unknown log level",
+			modify:  func(cfg *Config) { cfg.Log.Level = "verbose" },
+			problem: "log.level must be",
+		},
+		{
+			name:    "unknown log format",
+			modify:  func(cfg *Config) { cfg.Log.Format = "xml" },
+			problem: "log.format must be",
+		},
+		{
+			name:    "unknown sink",
+			modify:  func(cfg *Config) { cfg.Events.Sink = "kafka" },
+			problem: "events.sink must be",
+		},
+		{
+			name: "redis sink without stream",
+			modify: func(cfg *Config) {
+				cfg.Events.Sink = EventSinkRedis
+				cfg.Events.Stream = ""
+			},
+			problem: "events.stream is required for the redis sink",
+		},
+		{
+			name:    "file sink without path",
+			modify:  func(cfg *Config) { cfg.Events.Sink = EventSinkFile },
+			problem: "events.path is required for the file sink",
+		},
+		{
+			name:    "negative max length",
+			modify:  func(cfg *Config) { cfg.Events.MaxLen = -1 },
+			problem: "events.maxLen must not be negative",
+		},
+	}
+
+	if err := Default().Validate(); err != nil {
+		t.Fatalf("Default().Validate() error = %v: %v", err, err.Unwrap())
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			tt.modify(cfg)
+
+			err := cfg.Validate()
+			if !errors.Is(err, entity.ErrConfigInvalid) {
+				t.Fatalf("Validate() error = %v, want %v", err, entity.ErrConfigInvalid)
+			}
+			if problems := err.Unwrap().Error(); !strings.Contains(problems, tt.problem) {
+				t.Errorf("Validate() problems = %q, want %q", problems, tt.problem)
+			}
+		})
+	}
+}
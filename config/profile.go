@@ -0,0 +1,416 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/errs"
+	"gopkg.in/yaml.v3"
+)
+
+type MatchProfilesFile struct {
+	Profiles []*MatchProfileConfig `yaml:"profiles"`
+}
+
+type MatchProfileConfig struct {
+	Name string `yaml:"name"`
+	// MatchFunction is the name of the registered match function that makes matches for the profile.
+	MatchFunction string        `yaml:"matchFunction"`
+	Pools         []*PoolConfig `yaml:"pools"`
+	// Extensions is passed to the match function as JSON.
+	Extensions map[string]any `yaml:"extensions"`
+}
+
+type PoolConfig struct {
+	Name                string                      `yaml:"name"`
+	DoubleRangeFilters  []*DoubleRangeFilterConfig  `yaml:"doubleRangeFilters"`
+	StringEqualsFilters []*StringEqualsFilterConfig `yaml:"stringEqualsFilters"`
+	TagPresentFilters   []*TagPresentFilterConfig   `yaml:"tagPresentFilters"`
+	CreatedBefore       time.Time                   `yaml:"createdBefore"`
+	CreatedAfter        time.Time                   `yaml:"createdAfter"`
+}
+
+type DoubleRangeFilterConfig struct {
+	DoubleArg string  `yaml:"doubleArg"`
+	Min       float64 `yaml:"min"`
+	Max       float64 `yaml:"max"`
+	// Exclude is one of none, min, max and both. It defaults to none.
+	Exclude string `yaml:"exclude"`
+}
+
+type StringEqualsFilterConfig struct {
+	StringArg string `yaml:"stringArg"`
+	Value     string `yaml:"value"`
+}
+
+type TagPresentFilterConfig struct {
+	Tag string `yaml:"tag"`
+}
+
+var doubleRangeFilterExcludes = map[string]entity.DoubleRangeFilterExclude{
+	"":     entity.DoubleRangeFilterNone,
+	"none": entity.DoubleRangeFilterNone,
+	"min":  entity.DoubleRangeFilterMin,
+	"max":  entity.DoubleRangeFilterMax,
+	"both": entity.DoubleRangeFilterBoth,
+}
+
+// DefaultMatchProfiles returns the profile used when no match profiles file is configured.
+func DefaultMatchProfiles() []*MatchProfileConfig {
+	return []*MatchProfileConfig{
+		{
+			Name:          "simple-1vs1",
+			MatchFunction: "simple-1vs1",
+			Pools: []*PoolConfig{
+				{Name: "test-pool"},
+			},
+		},
+	}
+}
+
+// LoadMatchProfiles reads the match profiles from the file, or from all the YAML and JSON files in the directory.
+func LoadMatchProfiles(path string) ([]*MatchProfileConfig, *errs.Error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, entity.WithCause(entity.ErrMatchProfileLoadFailed, err)
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, entity.WithCause(entity.ErrMatchProfileLoadFailed, err)
+		}
+
+		files = files[:0]
+		for _, e := range entries {
+			switch strings.ToLower(filepath.Ext(e.Name())) {
+			case ".yaml", ".yml", ".json":
+				if !e.IsDir() {
+					files = append(files, filepath.Join(path, e.Name()))
+				}
+			}
+		}
+	}
+
+	var profiles []*MatchProfileConfig
+	for _, file := range files {
+		f, err := loadMatchProfilesFile(file)
+		if err != nil {
+			return nil, entity.WithCause(entity.ErrMatchProfileLoadFailed, fmt.Errorf("%s: %w", file, err))
+		}
+
+		profiles = append(profiles, f.Profiles...)
+	}
+
+	return profiles, nil
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// loadMatchProfilesFile decodes the file as YAML, which also accepts JSON.
+func loadMatchProfilesFile(path string) (*MatchProfilesFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var file MatchProfilesFile
+
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	return &file, nil
+}
+
+// BindMatchProfiles validates the profiles and binds each of them to the registered match function of its name.
+// All the problems found are reported together.
+func BindMatchProfiles(
+	profiles []*MatchProfileConfig,
+	matchFunctions map[string]entity.MatchFunction,
+) (map[*entity.MatchProfile]entity.MatchFunction, *errs.Error) {
+	report := &problems{}
+	if len(profiles) == 0 {
+		report.add("at least one profile is required")
+	}
+
+	bound := make(map[*entity.MatchProfile]entity.MatchFunction, len(profiles))
+	names := map[string]struct{}{}
+
+	for i, p := range profiles {
+		pp := report.at("profiles[%d]", i)
+		if p == nil {
+			pp.add("profile is empty")
+			continue
+		}
+		if p.Name != "" {
+			pp = report.at("profiles[%d](%s)", i, p.Name)
+		}
+
+		if _, ok := names[p.Name]; ok && p.Name != "" {
+			pp.add("name is duplicated")
+		}
+		names[p.Name] = struct{}{}
+
+		mmf, ok := matchFunctions[p.MatchFunction]
+		if !ok {
+			pp.add("match function %q is not registered", p.MatchFunction)
+		}
+
+		bound[p.toEntity(pp)] = mmf
+	}
+
+	if err := report.err(); err != nil {
+		return nil, entity.WithCause(entity.ErrMatchProfileInvalid, err)
+	}
+
+	return bound, nil
+}
+
+// BindMatchProfile validates the profile given at runtime in the same way as the profiles files,
+// and returns the registered match function of the name.
+func BindMatchProfile(
+	profile *entity.MatchProfile,
+	matchFunction string,
+	matchFunctions map[string]entity.MatchFunction,
+) (entity.MatchFunction, *errs.Error) {
+	if _, err := BindMatchProfiles([]*MatchProfileConfig{fromMatchProfile(profile, matchFunction)}, matchFunctions); err != nil {
+		return nil, err
+	}
+
+	return matchFunctions[matchFunction], nil
+}
+
+// fromMatchProfile converts the profile except for the extensions, which are not validated.
+func fromMatchProfile(profile *entity.MatchProfile, matchFunction string) *MatchProfileConfig {
+	if profile == nil {
+		return nil
+	}
+
+	p := &MatchProfileConfig{
+		Name:          profile.Name,
+		MatchFunction: matchFunction,
+		Pools:         make([]*PoolConfig, len(profile.Pools)),
+	}
+
+	for i, pool := range profile.Pools {
+		if pool == nil {
+			continue
+		}
+
+		pc := &PoolConfig{
+			Name:          pool.Name,
+			CreatedBefore: pool.CreatedBefore,
+			CreatedAfter:  pool.CreatedAfter,
+		}
+
+		for _, f := range pool.DoubleRangeFilters {
+			if f == nil {
+				pc.DoubleRangeFilters = append(pc.DoubleRangeFilters, nil)
+				continue
+			}
+
+			exclude := "invalid"
+			for name, e := range doubleRangeFilterExcludes {
+				if e == f.Exclude && name != "" {
+					exclude = name
+				}
+			}
+
+			pc.DoubleRangeFilters = append(pc.DoubleRangeFilters, &DoubleRangeFilterConfig{
+				DoubleArg: f.DoubleArg,
+				Min:       f.Min,
+				Max:       f.Max,
+				Exclude:   exclude,
+			})
+		}
+
+		for _, f := range pool.StringEqualsFilters {
+			if f == nil {
+				pc.StringEqualsFilters = append(pc.StringEqualsFilters, nil)
+				continue
+			}
+
+			pc.StringEqualsFilters = append(pc.StringEqualsFilters, &StringEqualsFilterConfig{
+				StringArg: f.StringArg,
+				Value:     f.Value,
+			})
+		}
+
+		for _, f := range pool.TagPresentFilters {
+			if f == nil {
+				pc.TagPresentFilters = append(pc.TagPresentFilters, nil)
+				continue
+			}
+
+			pc.TagPresentFilters = append(pc.TagPresentFilters, &TagPresentFilterConfig{
+				Tag: f.Tag,
+			})
+		}
+
+		p.Pools[i] = pc
+	}
+
+	return p
+}
+
+// problems collects the validation errors with the path to the invalid value.
+type problems struct {
+	prefix string
+	errs   *[]error
+}
+
+func (p *problems) at(format string, args ...any) *problems {
+	p.init()
+
+	prefix := fmt.Sprintf(format, args...)
+	if p.prefix != "" {
+		prefix = p.prefix + "." + prefix
+	}
+
+	return &problems{prefix: prefix, errs: p.errs}
+}
+
+func (p *problems) add(format string, args ...any) {
+	p.init()
+
+	msg := fmt.Sprintf(format, args...)
+	if p.prefix != "" {
+		msg = p.prefix + ": " + msg
+	}
+
+	*p.errs = append(*p.errs, errors.New(msg))
+}
+
+func (p *problems) init() {
+	if p.errs == nil {
+		p.errs = &[]error{}
+	}
+}
+
+func (p *problems) err() error {
+	if p.errs == nil {
+		return nil
+	}
+
+	return errors.Join(*p.errs...)
+}
+
+func (p *MatchProfileConfig) toEntity(problems *problems) *entity.MatchProfile {
+	if p.Name == "" {
+		problems.add("name is required")
+	}
+	if len(p.Pools) == 0 {
+		problems.add("at least one pool is required")
+	}
+
+	profile := &entity.MatchProfile{
+		Name:          p.Name,
+		Pools:         make([]*entity.Pool, 0, len(p.Pools)),
+		MatchFunction: p.MatchFunction,
+	}
+
+	if p.Extensions != nil {
+		extensions, err := json.Marshal(p.Extensions)
+		if err != nil {
+			problems.add("extensions: %v", err)
+		}
+		profile.Extensions = extensions
+	}
+
+	var poolNames []string
+	for i, pc := range p.Pools {
+		pp := problems.at("pools[%d]", i)
+		if pc == nil {
+			pp.add("pool is empty")
+			continue
+		}
+
+		if slices.Contains(poolNames, pc.Name) {
+			pp.add("name %q is duplicated", pc.Name)
+		}
+		poolNames = append(poolNames, pc.Name)
+
+		profile.Pools = append(profile.Pools, pc.toEntity(pp))
+	}
+
+	return profile
+}
+
+func (pc *PoolConfig) toEntity(problems *problems) *entity.Pool {
+	if pc.Name == "" {
+		problems.add("name is required")
+	}
+	if !pc.CreatedAfter.IsZero() && !pc.CreatedBefore.IsZero() && !pc.CreatedAfter.Before(pc.CreatedBefore) {
+		problems.add("createdAfter must be before createdBefore")
+	}
+
+	pool := &entity.Pool{
+		Name:          pc.Name,
+		CreatedBefore: pc.CreatedBefore,
+		CreatedAfter:  pc.CreatedAfter,
+	}
+
+	for i, f := range pc.DoubleRangeFilters {
+		fp := problems.at("doubleRangeFilters[%d]", i)
+		if f == nil || f.DoubleArg == "" {
+			fp.add("doubleArg is required")
+			continue
+		}
+
+		exclude, ok := doubleRangeFilterExcludes[strings.ToLower(f.Exclude)]
+		if !ok {
+			fp.add("exclude must be one of none, min, max and both")
+		}
+		if f.Min > f.Max {
+			fp.add("min must not be greater than max")
+		}
+
+		pool.DoubleRangeFilters = append(pool.DoubleRangeFilters, &entity.DoubleRangeFilter{
+			DoubleArg: f.DoubleArg,
+			Min:       f.Min,
+			Max:       f.Max,
+			Exclude:   exclude,
+		})
+	}
+
+	for i, f := range pc.StringEqualsFilters {
+		if f == nil || f.StringArg == "" {
+			problems.at("stringEqualsFilters[%d]", i).add("stringArg is required")
+			continue
+		}
+
+		pool.StringEqualsFilters = append(pool.StringEqualsFilters, &entity.StringEqualsFilter{
+			StringArg: f.StringArg,
+			Value:     f.Value,
+		})
+	}
+
+	for i, f := range pc.TagPresentFilters {
+		if f == nil || f.Tag == "" {
+			problems.at("tagPresentFilters[%d]", i).add("tag is required")
+			continue
+		}
+
+		pool.TagPresentFilters = append(pool.TagPresentFilters, &entity.TagPresentFilter{
+			Tag: f.Tag,
+		})
+	}
+
+	return pool
+}
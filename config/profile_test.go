@@ -0,0 +1,203 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+)
+
+var testMatchFunctions = map[string]entity.MatchFunction{
+	"simple-1vs1": entity.MatchFunctionFunc(func(context.Context, *entity.MatchProfile, map[string]entity.Tickets, map[string]entity.Backfills) (entity.Matches, error) {
+		return nil, nil
+	}),
+}
+
+func TestLoadMatchProfiles(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"a.yaml": "profiles:\n  - name: a\n    matchFunction: simple-1vs1\n    pools:\n      - name: all\n",
+		"b.json": `{"profiles": [{"name": "b", "matchFunction": "simple-1vs1", "pools": [{"name": "all"}]}]}`,
+		// Files with the other extensions are ignored.
+		"notes.txt": "not a profile",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	profiles, err := LoadMatchProfiles(dir)
+	if err != nil {
+		t.Fatalf("LoadMatchProfiles() error = %v: %v", err, err.Unwrap())
+	}
+
+	var names []string
+	for _, p := range profiles {
+		names = append(names, p.Name)
+	}
+	if !slices.Equal(names, []string{"a", "b"}) {
+		t.Errorf("profiles = %v, want [a b]", names)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "c.yaml"), []byte("profiles:\n  - nmae: c\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if _, err := LoadMatchProfiles(dir); !errors.Is(err, entity.ErrMatchProfileLoadFailed) {
+		t.Errorf("LoadMatchProfiles() with unknown key error = %v, want %v", err, entity.ErrMatchProfileLoadFailed)
+	}
+
+	if _, err := LoadMatchProfiles(filepath.Join(dir, "missing.yaml")); !errors.Is(err, entity.ErrMatchProfileLoadFailed) {
+		t.Errorf("LoadMatchProfiles() of missing file error = %v, want %v", err, entity.ErrMatchProfileLoadFailed)
+	}
+}
+
+func TestBindMatchProfiles(t *testing.T) {
+	pool := func() *PoolConfig { return &PoolConfig{Name: "all"} }
+	profile := func(name string, pools ...*PoolConfig) *MatchProfileConfig {
+		return &MatchProfileConfig{Name: name, MatchFunction: "simple-1vs1", Pools: pools}
+	}
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		profiles []*MatchProfileConfig
+		problems []string
+	}{
+		{
+			name:     "valid",
+			profiles: []*MatchProfileConfig{profile("a", pool()), profile("b", pool())},
+		},
+		{
+			name:     "no profiles",
+			problems: []string{"at least one profile is required"},
+		},
+		{
+			name:     "empty profile",
+			profiles: []*MatchProfileConfig{nil},
+			problems: []string{"profiles[0]: profile is empty"},
+		},
+		{
+			name:     "no name",
+			profiles: []*MatchProfileConfig{profile("", pool())},
+			problems: []string{"profiles[0]: name is required"},
+		},
+		{
+			name:     "duplicated names",
+			profiles: []*MatchProfileConfig{profile("a", pool()), profile("a", pool())},
+			problems: []string{"profiles[1](a): name is duplicated"},
+		},
+		{
+			name: "unknown match function",
+			profiles: []*MatchProfileConfig{
+				{Name: "a", MatchFunction: "5vs5", Pools: []*PoolConfig{pool()}},
+			},
+			problems: []string{`profiles[0](a): match function "5vs5" is not registered`},
+		},
+		{
+			name:     "no pools",
+			profiles: []*MatchProfileConfig{profile("a")},
+			problems: []string{"profiles[0](a): at least one pool is required"},
+		},
+		{
+			name:     "empty pool",
+			profiles: []*MatchProfileConfig{profile("a", nil)},
+			problems: []string{"profiles[0](a).pools[0]: pool is empty"},
+		},
+		{
+			name:     "duplicated pool names",
+			profiles: []*MatchProfileConfig{profile("a", pool(), pool())},
+			problems: []string{`profiles[0](a).pools[1]: name "all" is duplicated`},
+		},
+		{
+			name:     "pool without name",
+			profiles: []*MatchProfileConfig{profile("a", &PoolConfig{})},
+			problems: []string{"profiles[0](a).pools[0]: name is required"},
+		},
+		{
+			name: "min over max",
+			profiles: []*MatchProfileConfig{profile("a", &PoolConfig{
+				Name:               "all",
+				DoubleRangeFilters: []*DoubleRangeFilterConfig{{DoubleArg: "mmr", Min: 2000, Max: 1000}},
+			})},
+			problems: []string{"profiles[0](a).pools[0].doubleRangeFilters[0]: min must not be greater than max"},
+		},
+		{
+			name: "unknown exclude",
+			profiles: []*MatchProfileConfig{profile("a", &PoolConfig{
+				Name:               "all",
+				DoubleRangeFilters: []*DoubleRangeFilterConfig{{DoubleArg: "mmr", Max: 1000, Exclude: "middle"}},
+			})},
+			problems: []string{"profiles[0](a).pools[0].doubleRangeFilters[0]: exclude must be one of none, min, max and both"},
+		},
+		{
+			name: "filters without argument",
+			profiles: []*MatchProfileConfig{profile("a", &PoolConfig{
+				Name:                "all",
+				DoubleRangeFilters:  []*DoubleRangeFilterConfig{{Max: 1000}},
+				StringEqualsFilters: []*StringEqualsFilterConfig{{Value: "asia"}},
+				TagPresentFilters:   []*TagPresentFilterConfig{{}},
+			})},
+			problems: []string{
+				"profiles[0](a).pools[0].doubleRangeFilters[0]: doubleArg is required",
+				"profiles[0](a).pools[0].stringEqualsFilters[0]: stringArg is required",
+				"profiles[0](a).pools[0].tagPresentFilters[0]: tag is required",
+			},
+		},
+		{
+			name: "created after not before created before",
+			profiles: []*MatchProfileConfig{profile("a", &PoolConfig{
+				Name:          "all",
+				CreatedAfter:  base,
+				CreatedBefore: base,
+			})},
+			problems: []string{"profiles[0](a).pools[0]: createdAfter must be before createdBefore"},
+		},
+		{
+			name: "all problems together",
+			profiles: []*MatchProfileConfig{
+				{Name: "a", MatchFunction: "5vs5"},
+				profile("a", pool()),
+			},
+			problems: []string{
+				`profiles[0](a): match function "5vs5" is not registered`,
+				"profiles[0](a): at least one pool is required",
+				"profiles[1](a): name is duplicated",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bound, err := BindMatchProfiles(tt.profiles, testMatchFunctions)
+			if len(tt.problems) == 0 {
+				if err != nil {
+					t.Fatalf("BindMatchProfiles() error = %v: %v", err, err.Unwrap())
+				}
+				if len(bound) != len(tt.profiles) {
+					t.Errorf("bound %d profiles, want %d", len(bound), len(tt.profiles))
+				}
+				for profile, mmf := range bound {
+					if mmf == nil || profile.MatchFunction != "simple-1vs1" {
+						t.Errorf("profile %q is bound to %q", profile.Name, profile.MatchFunction)
+					}
+				}
+				return
+			}
+
+			if !errors.Is(err, entity.ErrMatchProfileInvalid) {
+				t.Fatalf("BindMatchProfiles() error = %v, want %v", err, entity.ErrMatchProfileInvalid)
+			}
+			got := strings.Split(err.Unwrap().Error(), "\n")
+			if !slices.Equal(got, tt.problems) {
+				t.Errorf("problems = %q, want %q", got, tt.problems)
+			}
+		})
+	}
+}
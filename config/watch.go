@@ -0,0 +1,74 @@
+package config
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/errs"
+	"github.com/fsnotify/fsnotify"
+)
+
+// Editors often write a file in several steps, so the events within the delay are coalesced into one reload.
+const reloadDelay = 500 * time.Millisecond
+
+// WatchMatchProfiles calls onChange when the profiles file, or a profiles file in the directory, is changed.
+// It blocks until the context is canceled.
+func WatchMatchProfiles(ctx context.Context, path string, onChange func()) *errs.Error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return entity.WithCause(entity.ErrMatchProfileWatchFailed, err)
+	}
+	defer watcher.Close()
+
+	path = filepath.Clean(path)
+	isTarget := func(name string) bool {
+		switch strings.ToLower(filepath.Ext(name)) {
+		case ".yaml", ".yml", ".json":
+			return filepath.Dir(filepath.Clean(name)) == path
+		default:
+			return false
+		}
+	}
+
+	dir := path
+	if !isDir(path) {
+		// The directory is watched instead of the file, because the file is replaced by renaming when saved by some editors.
+		dir = filepath.Dir(path)
+		isTarget = func(name string) bool {
+			return filepath.Clean(name) == path
+		}
+	}
+
+	if err := watcher.Add(dir); err != nil {
+		return entity.WithCause(entity.ErrMatchProfileWatchFailed, err)
+	}
+
+	timer := time.NewTimer(reloadDelay)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Has(fsnotify.Chmod) || !isTarget(event.Name) {
+				continue
+			}
+			timer.Reset(reloadDelay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			return entity.WithCause(entity.ErrMatchProfileWatchFailed, err)
+		case <-timer.C:
+			onChange()
+		}
+	}
+}
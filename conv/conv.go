@@ -0,0 +1,291 @@
+package conv
+
+import (
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/gen/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToSearchFields(pb *pb.SearchFields) *entity.SearchFields {
+	if pb == nil {
+		return &entity.SearchFields{}
+	}
+
+	return &entity.SearchFields{
+		DoubleArgs: pb.GetDoubleArgs(),
+		StringArgs: pb.GetStringArgs(),
+		Tags:       pb.GetTags(),
+	}
+}
+
+func ToMatchProfile(pb *pb.MatchProfile) *entity.MatchProfile {
+	if pb == nil {
+		return nil
+	}
+
+	pools := make([]*entity.Pool, 0, len(pb.GetPools()))
+	for _, pool := range pb.GetPools() {
+		pools = append(pools, ToPool(pool))
+	}
+
+	return &entity.MatchProfile{
+		Name:       pb.GetName(),
+		Pools:      pools,
+		Extensions: pb.GetExtensions(),
+	}
+}
+
+func ToPool(pb *pb.Pool) *entity.Pool {
+	pool := &entity.Pool{
+		Name:          pb.GetName(),
+		CreatedBefore: toTime(pb.GetCreatedBefore()),
+		CreatedAfter:  toTime(pb.GetCreatedAfter()),
+	}
+
+	for _, f := range pb.GetDoubleRangeFilters() {
+		pool.DoubleRangeFilters = append(pool.DoubleRangeFilters, &entity.DoubleRangeFilter{
+			DoubleArg: f.GetDoubleArg(),
+			Max:       f.GetMax(),
+			Min:       f.GetMin(),
+			Exclude:   entity.DoubleRangeFilterExclude(f.GetExclude()),
+		})
+	}
+
+	for _, f := range pb.GetStringEqualsFilters() {
+		pool.StringEqualsFilters = append(pool.StringEqualsFilters, &entity.StringEqualsFilter{
+			StringArg: f.GetStringArg(),
+			Value:     f.GetValue(),
+		})
+	}
+
+	for _, f := range pb.GetTagPresentFilters() {
+		pool.TagPresentFilters = append(pool.TagPresentFilters, &entity.TagPresentFilter{
+			Tag: f.GetTag(),
+		})
+	}
+
+	return pool
+}
+
+func ToAssignment(pb *pb.Assignment) *entity.Assignment {
+	if pb == nil {
+		return nil
+	}
+
+	return &entity.Assignment{
+		Connection: pb.GetConnection(),
+		Extensions: pb.GetExtensions(),
+	}
+}
+
+func ToTicket(pb *pb.Ticket) *entity.Ticket {
+	if pb == nil {
+		return nil
+	}
+
+	return &entity.Ticket{
+		ID:           pb.GetId(),
+		Assignment:   ToAssignment(pb.GetAssignment()),
+		SearchFields: ToSearchFields(pb.GetSearchFields()),
+		Extensions:   pb.GetExtensions(),
+		CreatedAt:    toTime(pb.GetCreateTime()),
+		Members:      pb.GetMembers(),
+	}
+}
+
+func ToBackfill(pb *pb.Backfill) *entity.Backfill {
+	if pb == nil {
+		return nil
+	}
+
+	return &entity.Backfill{
+		ID:           pb.GetId(),
+		SearchFields: ToSearchFields(pb.GetSearchFields()),
+		Extensions:   pb.GetExtensions(),
+		CreateTime:   toTime(pb.GetCreateTime()),
+		Generation:   pb.GetGeneration(),
+	}
+}
+
+func ToMatch(pb *pb.Match) *entity.Match {
+	if pb == nil {
+		return nil
+	}
+
+	tickets := make(entity.Tickets, 0, len(pb.GetTickets()))
+	for _, ticket := range pb.GetTickets() {
+		tickets = append(tickets, ToTicket(ticket))
+	}
+
+	return &entity.Match{
+		MatchID:            pb.GetMatchId(),
+		MatchProfile:       pb.GetMatchProfile(),
+		MatchFunction:      pb.GetMatchFunction(),
+		Tickets:            tickets,
+		Backfill:           ToBackfill(pb.GetBackfill()),
+		Extensions:         pb.GetExtensions(),
+		AllocateGameserver: pb.GetAllocateGameserver(),
+	}
+}
+
+func ToAssignmentGroups(pbs []*pb.AssignmentGroup) []*entity.AssignmentGroup {
+	asgs := make([]*entity.AssignmentGroup, 0, len(pbs))
+
+	for _, asg := range pbs {
+		asgs = append(asgs, &entity.AssignmentGroup{
+			TicketIds:  asg.GetTicketIds(),
+			Assignment: ToAssignment(asg.GetAssignment()),
+		})
+	}
+
+	return asgs
+}
+
+func ToPbSearchFields(searchFields *entity.SearchFields) *pb.SearchFields {
+	if searchFields == nil {
+		return nil
+	}
+
+	return &pb.SearchFields{
+		DoubleArgs: searchFields.DoubleArgs,
+		StringArgs: searchFields.StringArgs,
+		Tags:       searchFields.Tags,
+	}
+}
+
+func ToPbAssignment(assignment *entity.Assignment) *pb.Assignment {
+	if assignment == nil {
+		return nil
+	}
+
+	return &pb.Assignment{
+		Connection: assignment.Connection,
+		Extensions: assignment.Extensions,
+	}
+}
+
+func ToPbTicket(ticket *entity.Ticket) *pb.Ticket {
+	if ticket == nil {
+		return nil
+	}
+
+	return &pb.Ticket{
+		Id:           ticket.ID,
+		Assignment:   ToPbAssignment(ticket.Assignment),
+		SearchFields: ToPbSearchFields(ticket.SearchFields),
+		Extensions:   ticket.Extensions,
+		CreateTime:   toPbTimestamp(ticket.CreatedAt),
+		Members:      ticket.Members,
+	}
+}
+
+func ToPbBackfill(backfill *entity.Backfill) *pb.Backfill {
+	if backfill == nil {
+		return nil
+	}
+
+	return &pb.Backfill{
+		Id:           backfill.ID,
+		SearchFields: ToPbSearchFields(backfill.SearchFields),
+		Extensions:   backfill.Extensions,
+		CreateTime:   toPbTimestamp(backfill.CreateTime),
+		Generation:   backfill.Generation,
+	}
+}
+
+func ToPbMatch(match *entity.Match) *pb.Match {
+	tickets := make([]*pb.Ticket, 0, len(match.Tickets))
+	for _, ticket := range match.Tickets {
+		tickets = append(tickets, ToPbTicket(ticket))
+	}
+
+	return &pb.Match{
+		MatchId:            match.MatchID,
+		MatchProfile:       match.MatchProfile,
+		MatchFunction:      match.MatchFunction,
+		Tickets:            tickets,
+		Backfill:           ToPbBackfill(match.Backfill),
+		Extensions:         match.Extensions,
+		AllocateGameserver: match.AllocateGameserver,
+	}
+}
+
+func ToPbAssignmentGroups(asgs []*entity.AssignmentGroup) []*pb.AssignmentGroup {
+	pbs := make([]*pb.AssignmentGroup, 0, len(asgs))
+
+	for _, asg := range asgs {
+		pbs = append(pbs, &pb.AssignmentGroup{
+			TicketIds:  asg.TicketIds,
+			Assignment: ToPbAssignment(asg.Assignment),
+		})
+	}
+
+	return pbs
+}
+
+func ToPbMatchProfile(profile *entity.MatchProfile) *pb.MatchProfile {
+	if profile == nil {
+		return nil
+	}
+
+	pools := make([]*pb.Pool, 0, len(profile.Pools))
+	for _, pool := range profile.Pools {
+		pools = append(pools, ToPbPool(pool))
+	}
+
+	return &pb.MatchProfile{
+		Name:       profile.Name,
+		Pools:      pools,
+		Extensions: profile.Extensions,
+	}
+}
+
+func ToPbPool(pool *entity.Pool) *pb.Pool {
+	p := &pb.Pool{
+		Name:          pool.Name,
+		CreatedBefore: toPbTimestamp(pool.CreatedBefore),
+		CreatedAfter:  toPbTimestamp(pool.CreatedAfter),
+	}
+
+	for _, f := range pool.DoubleRangeFilters {
+		p.DoubleRangeFilters = append(p.DoubleRangeFilters, &pb.DoubleRangeFilter{
+			DoubleArg: f.DoubleArg,
+			Max:       f.Max,
+			Min:       f.Min,
+			Exclude:   pb.DoubleRangeFilter_Exclude(f.Exclude),
+		})
+	}
+
+	for _, f := range pool.StringEqualsFilters {
+		p.StringEqualsFilters = append(p.StringEqualsFilters, &pb.StringEqualsFilter{
+			StringArg: f.StringArg,
+			Value:     f.Value,
+		})
+	}
+
+	for _, f := range pool.TagPresentFilters {
+		p.TagPresentFilters = append(p.TagPresentFilters, &pb.TagPresentFilter{
+			Tag: f.Tag,
+		})
+	}
+
+	return p
+}
+
+func toTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
+
+func toPbTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
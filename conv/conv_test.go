@@ -0,0 +1,40 @@
+package conv
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+)
+
+func TestTicketRoundTrip(t *testing.T) {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 678901234, time.UTC)
+
+	tests := []struct {
+		name   string
+		ticket *entity.Ticket
+	}{
+		{
+			name:   "extensions and creation time",
+			ticket: &entity.Ticket{ID: "a", Extensions: []byte(`{"mode":"ranked"}`), CreatedAt: createdAt},
+		},
+		{
+			name:   "without extensions",
+			ticket: &entity.Ticket{ID: "b", CreatedAt: createdAt},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ToTicket(ToPbTicket(tt.ticket))
+
+			if !bytes.Equal(got.Extensions, tt.ticket.Extensions) {
+				t.Errorf("Extensions = %q, want %q", got.Extensions, tt.ticket.Extensions)
+			}
+			if !got.CreatedAt.Equal(tt.ticket.CreatedAt) {
+				t.Errorf("CreatedAt = %v, want %v", got.CreatedAt, tt.ticket.CreatedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package di
+
+import (
+	"time"
+
+	"github.com/HMasataka/collision/config"
+)
+
+// provideAssignedTTL passes the TTL of the assignments to the assigner service,
+// so that the domain does not depend on the config.
+func provideAssignedTTL(cfg *config.Config) time.Duration {
+	return cfg.Ticket.AssignedTTL
+}
@@ -5,11 +5,15 @@ package di
 
 import (
 	"context"
+	"log/slog"
 
+	"github.com/HMasataka/collision/config"
+	idriver "github.com/HMasataka/collision/domain/driver"
 	"github.com/HMasataka/collision/domain/entity"
 	"github.com/HMasataka/collision/domain/service"
 	"github.com/HMasataka/collision/infrastructure"
 	"github.com/HMasataka/collision/infrastructure/driver"
+	"github.com/HMasataka/collision/infrastructure/memory"
 	"github.com/HMasataka/collision/infrastructure/persistence"
 	"github.com/HMasataka/collision/usecase"
 	"github.com/google/wire"
@@ -17,18 +21,48 @@ import (
 
 func InitializeUseCase(
 	ctx context.Context,
+	cfg *config.Config,
+	logger *slog.Logger,
+	registry usecase.MatchFunctionRegistry,
 	matchFunctions map[*entity.MatchProfile]entity.MatchFunction,
 	assigner entity.Assigner,
 	evaluator entity.Evaluator,
+	eventSinkDriver idriver.EventSinkDriver,
 ) *usecase.UseCaseContainer {
 	wire.Build(
 		infrastructure.NewClient,
 		infrastructure.NewLocker,
 		driver.NewLockerDriver,
+		driver.NewAssignmentNotifierDriver,
 		persistence.NewRepositoryOnce,
 		usecase.NewUseCaseOnce,
 		service.NewTicketService,
 		service.NewAssignerService,
+		provideAssignedTTL,
+	)
+
+	return nil
+}
+
+func InitializeInMemoryUseCase(
+	ctx context.Context,
+	cfg *config.Config,
+	logger *slog.Logger,
+	registry usecase.MatchFunctionRegistry,
+	matchFunctions map[*entity.MatchProfile]entity.MatchFunction,
+	assigner entity.Assigner,
+	evaluator entity.Evaluator,
+	eventSinkDriver idriver.EventSinkDriver,
+) *usecase.UseCaseContainer {
+	wire.Build(
+		memory.NewStore,
+		memory.NewLockerDriver,
+		memory.NewAssignmentNotifierDriver,
+		memory.NewRepository,
+		usecase.NewUseCaseOnce,
+		service.NewTicketService,
+		service.NewAssignerService,
+		provideAssignedTTL,
 	)
 
 	return nil
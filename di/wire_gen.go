@@ -8,23 +8,41 @@ package di
 
 import (
 	"context"
+	"github.com/HMasataka/collision/config"
+	"github.com/HMasataka/collision/domain/driver"
 	"github.com/HMasataka/collision/domain/entity"
 	"github.com/HMasataka/collision/domain/service"
 	"github.com/HMasataka/collision/infrastructure"
-	"github.com/HMasataka/collision/infrastructure/driver"
+	driver2 "github.com/HMasataka/collision/infrastructure/driver"
+	"github.com/HMasataka/collision/infrastructure/memory"
 	"github.com/HMasataka/collision/infrastructure/persistence"
 	"github.com/HMasataka/collision/usecase"
+	"log/slog"
 )
 
 // Injectors from usecase.wire.go:
 
-func InitializeUseCase(ctx context.Context, matchFunctions map[*entity.MatchProfile]entity.MatchFunction, assigner entity.Assigner, evaluator entity.Evaluator) *usecase.UseCaseContainer {
-	client := infrastructure.NewClient()
-	locker := infrastructure.NewLocker()
-	lockerDriver := driver.NewLockerDriver(locker)
-	repositoryContainer := persistence.NewRepositoryOnce(client, lockerDriver)
-	ticketService := service.NewTicketService(client, lockerDriver, repositoryContainer)
-	assignerService := service.NewAssignerService(client, repositoryContainer, ticketService)
-	useCaseContainer := usecase.NewUseCaseOnce(matchFunctions, assigner, evaluator, repositoryContainer, ticketService, assignerService)
+func InitializeUseCase(ctx context.Context, cfg *config.Config, logger *slog.Logger, registry usecase.MatchFunctionRegistry, matchFunctions map[*entity.MatchProfile]entity.MatchFunction, assigner entity.Assigner, evaluator entity.Evaluator, eventSinkDriver driver.EventSinkDriver) *usecase.UseCaseContainer {
+	client := infrastructure.NewClient(cfg)
+	locker := infrastructure.NewLocker(cfg)
+	lockerDriver := driver2.NewLockerDriver(locker)
+	repositoryContainer := persistence.NewRepositoryOnce(client, lockerDriver, cfg)
+	ticketService := service.NewTicketService(repositoryContainer, eventSinkDriver)
+	assignmentNotifierDriver := driver2.NewAssignmentNotifierDriver(client, logger)
+	duration := provideAssignedTTL(cfg)
+	assignerService := service.NewAssignerService(assignmentNotifierDriver, repositoryContainer, ticketService, eventSinkDriver, duration)
+	useCaseContainer := usecase.NewUseCaseOnce(registry, matchFunctions, assigner, evaluator, repositoryContainer, ticketService, assignerService, cfg, logger)
+	return useCaseContainer
+}
+
+func InitializeInMemoryUseCase(ctx context.Context, cfg *config.Config, logger *slog.Logger, registry usecase.MatchFunctionRegistry, matchFunctions map[*entity.MatchProfile]entity.MatchFunction, assigner entity.Assigner, evaluator entity.Evaluator, eventSinkDriver driver.EventSinkDriver) *usecase.UseCaseContainer {
+	store := memory.NewStore(ctx)
+	lockerDriver := memory.NewLockerDriver()
+	repositoryContainer := memory.NewRepository(store, lockerDriver, cfg)
+	ticketService := service.NewTicketService(repositoryContainer, eventSinkDriver)
+	assignmentNotifierDriver := memory.NewAssignmentNotifierDriver()
+	duration := provideAssignedTTL(cfg)
+	assignerService := service.NewAssignerService(assignmentNotifierDriver, repositoryContainer, ticketService, eventSinkDriver, duration)
+	useCaseContainer := usecase.NewUseCaseOnce(registry, matchFunctions, assigner, evaluator, repositoryContainer, ticketService, assignerService, cfg, logger)
 	return useCaseContainer
 }
@@ -0,0 +1,15 @@
+package driver
+
+import (
+	"context"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/errs"
+)
+
+type AssignmentNotifierDriver interface {
+	Publish(ctx context.Context, asg *entity.AssignmentGroup) *errs.Error
+	// Subscribe returns a channel that receives the assignments of the ticket and a function to stop receiving them.
+	// The channel is closed when the subscription is dropped.
+	Subscribe(ctx context.Context, ticketID string) (<-chan *entity.Assignment, func(), *errs.Error)
+}
@@ -0,0 +1,13 @@
+package driver
+
+import (
+	"context"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/errs"
+)
+
+// EventSinkDriver delivers the ticket lifecycle events to the consumers out of the server.
+type EventSinkDriver interface {
+	Emit(ctx context.Context, events []*entity.TicketEvent) *errs.Error
+}
@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+// Backfill is a request from a running game server to add players to its match.
+// The tickets matched into the backfill get the assignment of the game server when it acknowledges the backfill.
+type Backfill struct {
+	ID              string         `json:"id"`
+	SearchFields    *SearchFields  `json:"search_fields"`
+	Extensions      []byte         `json:"extensions"`
+	PersistentField map[string]any `json:"persistent_field"`
+	CreateTime      time.Time      `json:"create_time"`
+	// Generation is incremented on every change, and a match for the backfill is discarded
+	// when the backfill has been changed since the match function read it.
+	Generation int64 `json:"generation"`
+	// TicketIDs is the IDs of the tickets matched into the backfill and waiting for the acknowledgement.
+	TicketIDs []string `json:"ticket_ids"`
+}
+
+type Backfills []*Backfill
+
+func (b Backfills) IDs() []string {
+	ids := make([]string, 0, len(b))
+
+	for _, backfill := range b {
+		if backfill == nil {
+			continue
+		}
+
+		ids = append(ids, backfill.ID)
+	}
+
+	return ids
+}
@@ -1,19 +1,28 @@
 package entity
 
-import "github.com/HMasataka/errs"
+import (
+	"errors"
+
+	"github.com/HMasataka/errs"
+)
 
 // Assignment related errors
 var (
-	ErrAssignmentNotFound     *errs.Error = errs.New("assignment not found")
-	ErrAssignmentGetFailed    *errs.Error = errs.New("failed to get assignment")
-	ErrAssignmentDecodeFailed *errs.Error = errs.New("failed to decode assignment")
-	ErrAssignmentEncodeFailed *errs.Error = errs.New("failed to encode assignment")
-	ErrAssignmentSetFailed    *errs.Error = errs.New("failed to set assignment data")
-	ErrAssignmentWatchFailed  *errs.Error = errs.New("failed to watch assignments")
+	ErrAssignmentNotFound        *errs.Error = errs.New("assignment not found")
+	ErrAssignmentGetFailed       *errs.Error = errs.New("failed to get assignment")
+	ErrAssignmentDecodeFailed    *errs.Error = errs.New("failed to decode assignment")
+	ErrAssignmentEncodeFailed    *errs.Error = errs.New("failed to encode assignment")
+	ErrAssignmentSetFailed       *errs.Error = errs.New("failed to set assignment data")
+	ErrAssignmentWatchFailed     *errs.Error = errs.New("failed to watch assignments")
+	ErrAssignmentPublishFailed   *errs.Error = errs.New("failed to publish assignment")
+	ErrAssignmentSubscribeFailed *errs.Error = errs.New("failed to subscribe assignments")
 )
 
 // Ticket related errors
 var (
+	ErrTicketNotFound         *errs.Error = errs.New("ticket not found")
+	ErrTicketInvalid          *errs.Error = errs.New("invalid ticket")
+	ErrTicketGone             *errs.Error = errs.New("ticket was deleted or expired")
 	ErrTicketGetFailed        *errs.Error = errs.New("failed to get ticket")
 	ErrTicketCreateFailed     *errs.Error = errs.New("failed to create ticket")
 	ErrTicketDeleteFailed     *errs.Error = errs.New("failed to delete ticket")
@@ -23,6 +32,17 @@ var (
 	ErrTicketExpirationFailed *errs.Error = errs.New("failed to set ticket expiration")
 )
 
+// Backfill related errors
+var (
+	ErrBackfillNotFound           *errs.Error = errs.New("backfill not found")
+	ErrBackfillGenerationMismatch *errs.Error = errs.New("backfill generation mismatch")
+	ErrBackfillGetFailed          *errs.Error = errs.New("failed to get backfill")
+	ErrBackfillSetFailed          *errs.Error = errs.New("failed to set backfill")
+	ErrBackfillDeleteFailed       *errs.Error = errs.New("failed to delete backfill")
+	ErrBackfillMarshalFailed      *errs.Error = errs.New("failed to marshal backfill")
+	ErrBackfillUnmarshalFailed    *errs.Error = errs.New("failed to unmarshal backfill")
+)
+
 // Lock related errors
 var (
 	ErrLockAcquisitionFailed *errs.Error = errs.New("failed to acquire lock")
@@ -40,6 +60,16 @@ var (
 	ErrMatchExecutionFailed  *errs.Error = errs.New("failed to execute match functions")
 	ErrMatchEvaluationFailed *errs.Error = errs.New("failed to evaluate matches")
 	ErrMatchAssignFailed     *errs.Error = errs.New("failed to assign matches")
+	ErrMatchFunctionNotFound *errs.Error = errs.New("match function not found")
+	ErrMatchProfileNotFound  *errs.Error = errs.New("match profile not found")
+	ErrMatchProfilesWatched  *errs.Error = errs.New("match profiles are managed by the watched profiles files")
+
+	ErrRemoteMatchFunctionFailed  *errs.Error = errs.New("remote match function failed")
+	ErrRemoteMatchFunctionInvalid *errs.Error = errs.New("remote match function returned an invalid match")
+	ErrRemoteEvaluatorFailed      *errs.Error = errs.New("remote evaluator failed")
+	ErrRemoteEvaluatorInvalid     *errs.Error = errs.New("remote evaluator returned an invalid result")
+	ErrRemoteAssignerFailed       *errs.Error = errs.New("remote assigner failed")
+	ErrRemoteAssignerInvalid      *errs.Error = errs.New("remote assigner returned an invalid assignment")
 )
 
 // Pending ticket related errors
@@ -49,7 +79,61 @@ var (
 	ErrPendingTicketReleaseFailed *errs.Error = errs.New("failed to release tickets")
 )
 
+// Ticket event related errors
+var (
+	ErrEventEncodeFailed    *errs.Error = errs.New("failed to encode ticket events")
+	ErrEventEmitFailed      *errs.Error = errs.New("failed to emit ticket events")
+	ErrEventSinkSetupFailed *errs.Error = errs.New("failed to set up ticket event sink")
+)
+
+// Config related errors
+var (
+	ErrConfigLoadFailed   *errs.Error = errs.New("failed to load config")
+	ErrConfigInvalid      *errs.Error = errs.New("invalid config")
+	ErrTracingSetupFailed *errs.Error = errs.New("failed to set up tracing")
+
+	ErrMatchProfileLoadFailed  *errs.Error = errs.New("failed to load match profiles")
+	ErrMatchProfileInvalid     *errs.Error = errs.New("invalid match profiles")
+	ErrMatchProfileWatchFailed *errs.Error = errs.New("failed to watch match profiles")
+)
+
 // Redis operation errors
 var (
 	ErrRedisOperationFailed *errs.Error = errs.New("redis operation failed")
+	ErrRedisConnectFailed   *errs.Error = errs.New("failed to connect to redis")
+)
+
+// Request related errors
+var (
+	ErrRequestDecodeFailed *errs.Error = errs.New("failed to decode request")
 )
+
+// WithCause returns a new error of the sentinel caused by cause.
+// The sentinels are shared, so they are never given a cause in place. The new error matches the sentinel with errors.Is.
+func WithCause(sentinel *errs.Error, cause error) *errs.Error {
+	return errs.New(sentinel.ID()).WithCause(cause)
+}
+
+// WalkError calls fn for each error in the chain until fn returns false.
+func WalkError(err error, fn func(error) bool) {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if !fn(err) {
+			return
+		}
+	}
+}
+
+// ErrorMessages returns the messages of the error chain from the outermost, as errs.Error only reports its own reason.
+func ErrorMessages(err error) []string {
+	var messages []string
+
+	WalkError(err, func(e error) bool {
+		messages = append(messages, e.Error())
+
+		// Errors other than errs.Error already include the messages of their causes.
+		_, ok := e.(*errs.Error)
+		return ok
+	})
+
+	return messages
+}
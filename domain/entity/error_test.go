@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/HMasataka/errs"
+)
+
+func TestErrorMessages(t *testing.T) {
+	tests := []struct {
+		name string
+		err  func() error
+		want []string
+	}{
+		{
+			name: "nil",
+			err:  func() error { return nil },
+		},
+		{
+			name: "plain error",
+			err:  func() error { return errors.New("boom") },
+			want: []string{"boom"},
+		},
+		{
+			name: "causes",
+			err: func() error {
+				inner := errs.New("inner").WithCause(fmt.Errorf("call: %w", errors.New("boom")))
+				return errs.New("outer").WithCause(inner)
+			},
+			want: []string{"outer", "inner", "call: boom"},
+		},
+		{
+			name: "wrapped by fmt",
+			err: func() error {
+				return fmt.Errorf("fetch: %w", errs.New("inner").WithCause(errors.New("boom")))
+			},
+			want: []string{"fetch: inner"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorMessages(tt.err()); !slices.Equal(got, tt.want) {
+				t.Errorf("ErrorMessages() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithCause(t *testing.T) {
+	sentinel := errs.New("sentinel")
+	cause := errors.New("boom")
+
+	err := WithCause(sentinel, cause)
+
+	if !errors.Is(err, sentinel) {
+		t.Errorf("errors.Is(err, sentinel) = false, want true")
+	}
+	if !errors.Is(err, cause) {
+		t.Errorf("errors.Is(err, cause) = false, want true")
+	}
+	if err.Error() != sentinel.Error() {
+		t.Errorf("Error() = %q, want %q", err.Error(), sentinel.Error())
+	}
+	if sentinel.Unwrap() != nil {
+		t.Errorf("sentinel.Unwrap() = %v, want nil", sentinel.Unwrap())
+	}
+
+	// The same sentinel given to itself as the cause does not make the chain loop.
+	if got := ErrorMessages(WithCause(sentinel, WithCause(sentinel, cause))); !slices.Equal(got, []string{"sentinel", "sentinel", "boom"}) {
+		t.Errorf("ErrorMessages() = %q", got)
+	}
+}
@@ -0,0 +1,47 @@
+package entity
+
+import "time"
+
+// TicketEventType is the state transition of a ticket.
+// The fetches and the releases by the match loop are not events,
+// as every waiting ticket would be fetched and released again on every tick.
+type TicketEventType string
+
+const (
+	// TicketEventCreated is emitted when the ticket is created.
+	TicketEventCreated TicketEventType = "created"
+	// TicketEventMatched is emitted when the ticket is in a match that passed the evaluator.
+	TicketEventMatched TicketEventType = "matched"
+	// TicketEventAssigned is emitted when the assignment of the ticket is stored.
+	TicketEventAssigned TicketEventType = "assigned"
+	// TicketEventDeleted is emitted when the ticket is deleted by the client.
+	TicketEventDeleted TicketEventType = "deleted"
+	// TicketEventExpired is emitted when a fetch finds that the ticket has expired, and the ticket is deindexed.
+	TicketEventExpired TicketEventType = "expired"
+)
+
+// TicketEvent is a state transition of a ticket, emitted for the analytics and the audit.
+type TicketEvent struct {
+	Type     TicketEventType `json:"type"`
+	TicketID string          `json:"ticket_id"`
+	Time     time.Time       `json:"time"`
+	// MatchID and MatchProfile are set on the matched events.
+	MatchID      string `json:"match_id,omitempty"`
+	MatchProfile string `json:"match_profile,omitempty"`
+	// Assignment is set on the assigned events.
+	Assignment *Assignment `json:"assignment,omitempty"`
+}
+
+// NewTicketEvents returns the events of the same type for the tickets.
+func NewTicketEvents(eventType TicketEventType, ticketIDs []string, now time.Time) []*TicketEvent {
+	events := make([]*TicketEvent, len(ticketIDs))
+	for i, ticketID := range ticketIDs {
+		events[i] = &TicketEvent{
+			Type:     eventType,
+			TicketID: ticketID,
+			Time:     now,
+		}
+	}
+
+	return events
+}
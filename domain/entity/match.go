@@ -2,7 +2,7 @@ package entity
 
 import (
 	"context"
-	"time"
+	"encoding/json"
 
 	"github.com/samber/lo"
 )
@@ -19,6 +19,26 @@ type Match struct {
 
 type Matches []*Match
 
+// Score returns the score in the match extensions, such as {"score": 1.5}.
+// It is zero if the extensions have no score or are not a JSON object.
+// The scale is up to the match function, so the scores are only comparable among the matches of the same profile.
+func (m *Match) Score() float64 {
+	var ext struct {
+		Score float64 `json:"score"`
+	}
+	if err := json.Unmarshal(m.Extensions, &ext); err != nil {
+		return 0
+	}
+
+	return ext.Score
+}
+
+func (m Matches) IDs() []string {
+	return lo.Map(m, func(match *Match, _ int) string {
+		return match.MatchID
+	})
+}
+
 func (m Matches) TicketIDs() []string {
 	return lo.FlatMap(m, func(match *Match, _ int) []string {
 		return match.Tickets.IDs()
@@ -44,28 +64,22 @@ func (m Matches) SplitByIDs(ids []string) (matched Matches, unmatched Matches) {
 	return matched, unmatched
 }
 
-type Backfill struct {
-	ID              string
-	SearchFields    *SearchFields
-	Extensions      []byte
-	PersistentField map[string]any
-	CreateTime      time.Time
-	Generation      int64
-}
-
 type MatchProfile struct {
 	Name       string
 	Pools      []*Pool
 	Extensions []byte
+	// MatchFunction is the name of the registered match function the profile is bound to.
+	MatchFunction string
 }
 
 // MatchFunction performs matchmaking based on Ticket for each fetched Pool.
+// The backfills in each pool can be filled by returning a match with the backfill of the same generation.
 type MatchFunction interface {
-	MakeMatches(ctx context.Context, profile *MatchProfile, poolTickets map[string]Tickets) (Matches, error)
+	MakeMatches(ctx context.Context, profile *MatchProfile, poolTickets map[string]Tickets, poolBackfills map[string]Backfills) (Matches, error)
 }
 
-type MatchFunctionFunc func(ctx context.Context, profile *MatchProfile, poolTickets map[string]Tickets) (Matches, error)
+type MatchFunctionFunc func(ctx context.Context, profile *MatchProfile, poolTickets map[string]Tickets, poolBackfills map[string]Backfills) (Matches, error)
 
-func (f MatchFunctionFunc) MakeMatches(ctx context.Context, profile *MatchProfile, poolTickets map[string]Tickets) (Matches, error) {
-	return f(ctx, profile, poolTickets)
+func (f MatchFunctionFunc) MakeMatches(ctx context.Context, profile *MatchProfile, poolTickets map[string]Tickets, poolBackfills map[string]Backfills) (Matches, error) {
+	return f(ctx, profile, poolTickets, poolBackfills)
 }
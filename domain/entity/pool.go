@@ -16,12 +16,24 @@ func (pf *Pool) In(ticket *Ticket) bool {
 		return false
 	}
 
-	s := ticket.SearchFields
+	return pf.matches(ticket.SearchFields, ticket.CreatedAt)
+}
+
+// BackfillIn reports whether the backfill matches the filters in the same way as the tickets.
+func (pf *Pool) BackfillIn(backfill *Backfill) bool {
+	if backfill == nil {
+		return false
+	}
+
+	return pf.matches(backfill.SearchFields, backfill.CreateTime)
+}
+
+func (pf *Pool) matches(s *SearchFields, createdAt time.Time) bool {
 	if s == nil {
 		s = &SearchFields{}
 	}
 
-	return pf.matchesCreatedTime(ticket.CreatedAt) &&
+	return pf.matchesCreatedTime(createdAt) &&
 		pf.matchesDoubleRanges(s) &&
 		pf.matchesStringEquals(s) &&
 		pf.matchesTags(s)
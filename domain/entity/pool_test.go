@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPoolInCreatedTime(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		pool      *Pool
+		createdAt time.Time
+		want      bool
+	}{
+		{
+			name:      "no filters",
+			pool:      &Pool{},
+			createdAt: base,
+			want:      true,
+		},
+		{
+			name:      "created after the bound",
+			pool:      &Pool{CreatedAfter: base},
+			createdAt: base.Add(time.Second),
+			want:      true,
+		},
+		{
+			name:      "created at the after bound",
+			pool:      &Pool{CreatedAfter: base},
+			createdAt: base,
+			want:      false,
+		},
+		{
+			name:      "created before the after bound",
+			pool:      &Pool{CreatedAfter: base},
+			createdAt: base.Add(-time.Second),
+			want:      false,
+		},
+		{
+			name:      "created before the bound",
+			pool:      &Pool{CreatedBefore: base},
+			createdAt: base.Add(-time.Second),
+			want:      true,
+		},
+		{
+			name:      "created at the before bound",
+			pool:      &Pool{CreatedBefore: base},
+			createdAt: base,
+			want:      false,
+		},
+		{
+			name:      "created after the before bound",
+			pool:      &Pool{CreatedBefore: base},
+			createdAt: base.Add(time.Second),
+			want:      false,
+		},
+		{
+			name:      "created within both bounds",
+			pool:      &Pool{CreatedAfter: base, CreatedBefore: base.Add(time.Minute)},
+			createdAt: base.Add(30 * time.Second),
+			want:      true,
+		},
+		{
+			name:      "created out of both bounds",
+			pool:      &Pool{CreatedAfter: base, CreatedBefore: base.Add(time.Minute)},
+			createdAt: base.Add(2 * time.Minute),
+			want:      false,
+		},
+		{
+			name:      "ticket without creation time",
+			pool:      &Pool{CreatedAfter: base},
+			createdAt: time.Time{},
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ticket := &Ticket{ID: "ticket", CreatedAt: tt.createdAt}
+			if got := tt.pool.In(ticket); got != tt.want {
+				t.Errorf("In() = %v, want %v", got, tt.want)
+			}
+
+			backfill := &Backfill{ID: "backfill", CreateTime: tt.createdAt}
+			if got := tt.pool.BackfillIn(backfill); got != tt.want {
+				t.Errorf("BackfillIn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SkillMatchProfileExtensions is the MatchProfile.Extensions read by the skill match function.
+type SkillMatchProfileExtensions struct {
+	// RatingArg is the double arg of the search fields holding the rating. It defaults to mmr.
+	RatingArg string `json:"rating_arg"`
+	// MatchSize is the number of players in a match. A party is counted by its members. It defaults to 2.
+	MatchSize int `json:"match_size"`
+	// MaxSpread is the allowed difference between the highest and the lowest rating in a match.
+	MaxSpread float64 `json:"max_spread"`
+	// SpreadPerSecond widens the allowed spread by the seconds the oldest ticket in the match has waited.
+	SpreadPerSecond float64 `json:"spread_per_second"`
+	// MaxSpreadLimit caps the widened spread. The spread is not capped if it is zero.
+	MaxSpreadLimit float64 `json:"max_spread_limit"`
+}
+
+// AllowedSpread returns the allowed rating spread for a match whose oldest ticket has waited for the duration.
+func (e *SkillMatchProfileExtensions) AllowedSpread(wait time.Duration) float64 {
+	spread := e.MaxSpread + e.SpreadPerSecond*max(wait.Seconds(), 0)
+	if e.MaxSpreadLimit > 0 {
+		spread = min(spread, e.MaxSpreadLimit)
+	}
+
+	return spread
+}
+
+// SkillMatchExtensions is the Match.Extensions written by the skill match function
+// so that the evaluator can compare the quality of the matches.
+type SkillMatchExtensions struct {
+	// Score is the negative rating spread, so that the default evaluator prefers the closer matches.
+	Score              float64 `json:"score"`
+	RatingSpread       float64 `json:"rating_spread"`
+	AverageRating      float64 `json:"average_rating"`
+	AverageWaitSeconds float64 `json:"average_wait_seconds"`
+}
+
+func ParseSkillMatchProfileExtensions(extensions []byte) (*SkillMatchProfileExtensions, error) {
+	ext := &SkillMatchProfileExtensions{RatingArg: "mmr", MatchSize: 2}
+
+	if len(extensions) > 0 {
+		if err := json.Unmarshal(extensions, ext); err != nil {
+			return nil, fmt.Errorf("invalid skill match profile extensions: %w", err)
+		}
+	}
+
+	if ext.RatingArg == "" {
+		return nil, fmt.Errorf("rating_arg must not be empty")
+	}
+	if ext.MatchSize < 2 {
+		return nil, fmt.Errorf("match_size must be at least 2: %d", ext.MatchSize)
+	}
+	if ext.MaxSpread < 0 {
+		return nil, fmt.Errorf("max_spread must not be negative: %v", ext.MaxSpread)
+	}
+	if ext.SpreadPerSecond < 0 {
+		return nil, fmt.Errorf("spread_per_second must not be negative: %v", ext.SpreadPerSecond)
+	}
+	if ext.MaxSpreadLimit < 0 {
+		return nil, fmt.Errorf("max_spread_limit must not be negative: %v", ext.MaxSpreadLimit)
+	}
+
+	return ext, nil
+}
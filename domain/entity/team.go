@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// TeamMatchProfileExtensions is the MatchProfile.Extensions read by the team match function.
+type TeamMatchProfileExtensions struct {
+	// TeamCount is the number of teams in a match. It defaults to 2.
+	TeamCount int `json:"team_count"`
+	// TeamSize is the number of players in a team. A party is counted by its members and never split.
+	TeamSize int `json:"team_size"`
+}
+
+// TeamMatchExtensions is the Match.Extensions written by the team match function.
+type TeamMatchExtensions struct {
+	Teams []*Team `json:"teams"`
+}
+
+type Team struct {
+	Name      string   `json:"name"`
+	TicketIDs []string `json:"ticket_ids"`
+}
+
+// TeamBackfillExtensions is the Backfill.Extensions read by the team match function to fill a running match.
+// The other keys in the extensions are kept as they are when the open slots are updated.
+type TeamBackfillExtensions struct {
+	// OpenSlots is the number of players that each team can still take, keyed by the team name.
+	OpenSlots map[string]int `json:"open_slots"`
+}
+
+func ParseTeamMatchProfileExtensions(extensions []byte) (*TeamMatchProfileExtensions, error) {
+	ext := &TeamMatchProfileExtensions{TeamCount: 2}
+
+	if len(extensions) > 0 {
+		if err := json.Unmarshal(extensions, ext); err != nil {
+			return nil, fmt.Errorf("invalid team match profile extensions: %w", err)
+		}
+	}
+
+	if ext.TeamCount < 1 {
+		return nil, fmt.Errorf("team_count must be positive: %d", ext.TeamCount)
+	}
+	if ext.TeamSize < 1 {
+		return nil, fmt.Errorf("team_size must be positive: %d", ext.TeamSize)
+	}
+
+	return ext, nil
+}
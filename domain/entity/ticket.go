@@ -11,6 +11,13 @@ type Ticket struct {
 	Extensions      []byte         `json:"extensions"`
 	PersistentField map[string]any `json:"persistent_field"`
 	CreatedAt       time.Time      `json:"created_at"`
+	// Members is the IDs of the players in the party. A ticket without members is a single player.
+	Members []string `json:"members,omitempty"`
+}
+
+// PartySize returns the number of players in the ticket.
+func (t *Ticket) PartySize() int {
+	return max(len(t.Members), 1)
 }
 
 type Tickets []*Ticket
@@ -29,6 +36,18 @@ func (t Tickets) IDs() []string {
 	return ids
 }
 
+// PartySize returns the total number of players in the tickets.
+func (t Tickets) PartySize() int {
+	size := 0
+	for _, ticket := range t {
+		if ticket != nil {
+			size += ticket.PartySize()
+		}
+	}
+
+	return size
+}
+
 type SearchFields struct {
 	DoubleArgs map[string]float64 `json:"double_args"`
 	StringArgs map[string]string  `json:"string_args"`
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/errs"
+)
+
+type AssignmentRepository interface {
+	Find(ctx context.Context, ticketID string) (*entity.Assignment, *errs.Error)
+	Insert(ctx context.Context, ticketIDs []string, assignment *entity.Assignment, ttl time.Duration) *errs.Error
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/errs"
+)
+
+type BackfillRepository interface {
+	Find(ctx context.Context, id string) (*entity.Backfill, *errs.Error)
+	// GetBackfills returns all the backfills, and drops the expired ones from the index.
+	GetBackfills(ctx context.Context) (entity.Backfills, *errs.Error)
+	Insert(ctx context.Context, backfill *entity.Backfill, ttl time.Duration) *errs.Error
+	// Update stores the backfill only if the stored backfill is of the generation,
+	// and returns ErrBackfillGenerationMismatch otherwise.
+	Update(ctx context.Context, backfill *entity.Backfill, generation int64, ttl time.Duration) *errs.Error
+	Delete(ctx context.Context, id string) *errs.Error
+}
@@ -4,4 +4,7 @@ type RepositoryContainer struct {
 	TicketRepository        TicketRepository
 	TicketIDRepository      TicketIDRepository
 	PendingTicketRepository PendingTicketRepository
+	AssignmentRepository    AssignmentRepository
+	TicketIndexRepository   TicketIndexRepository
+	BackfillRepository      BackfillRepository
 }
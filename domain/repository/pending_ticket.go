@@ -7,9 +7,11 @@ import (
 )
 
 type PendingTicketRepository interface {
-	PendingTicketKey() string
-
 	GetPendingTicketIDs(ctx context.Context) ([]string, *errs.Error)
+	CountPendingTickets(ctx context.Context) (int64, *errs.Error)
 	InsertPendingTicket(ctx context.Context, ticketIDs []string) *errs.Error
-	ReleaseTickets(ctx context.Context, ticketIDs []string) *errs.Error
+	// RefreshPendingTickets updates the pending time of the tickets so that they are not released by the pending release timeout.
+	// The tickets that are not pending are ignored.
+	RefreshPendingTickets(ctx context.Context, ticketIDs []string) *errs.Error
+	DeletePendingTickets(ctx context.Context, ticketIDs []string) *errs.Error
 }
@@ -2,15 +2,17 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/HMasataka/collision/domain/entity"
 	"github.com/HMasataka/errs"
 )
 
 type TicketRepository interface {
-	TicketDataKey(ticketID string) string
-
 	GetTickets(ctx context.Context, ticketIDs []string) (entity.Tickets, []string, *errs.Error)
 	Find(ctx context.Context, id string) (*entity.Ticket, *errs.Error)
+	Exists(ctx context.Context, id string) (bool, *errs.Error)
+	Insert(ctx context.Context, target *entity.Ticket, ttl time.Duration) *errs.Error
+	Expire(ctx context.Context, ticketIDs []string, ttl time.Duration) *errs.Error
 	Delete(ctx context.Context, target *entity.Ticket) *errs.Error
 }
@@ -7,7 +7,8 @@ import (
 )
 
 type TicketIDRepository interface {
-	TicketIDKey() string
-
 	GetAllTicketIDs(ctx context.Context, limit int64) ([]string, *errs.Error)
+	CountTicketIDs(ctx context.Context) (int64, *errs.Error)
+	Insert(ctx context.Context, ticketID string) *errs.Error
+	Delete(ctx context.Context, ticketIDs []string) *errs.Error
 }
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/HMasataka/errs"
+)
+
+// TicketIndexRepository updates the ticket index and the pending tickets
+// so that a ticket is never fetched by more than one worker at a time.
+type TicketIndexRepository interface {
+	// FetchActiveTicketIDs returns up to limit ticket IDs that are not pending and marks them as pending.
+	FetchActiveTicketIDs(ctx context.Context, limit int64) ([]string, *errs.Error)
+	// DeindexTickets removes the tickets from the ticket index and the pending tickets.
+	DeindexTickets(ctx context.Context, ticketIDs []string) *errs.Error
+	// ReleaseTickets removes the tickets from the pending tickets so that they can be fetched again.
+	ReleaseTickets(ctx context.Context, ticketIDs []string) *errs.Error
+}
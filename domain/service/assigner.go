@@ -2,139 +2,99 @@ package service
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"time"
 
+	"github.com/HMasataka/collision/domain/driver"
 	"github.com/HMasataka/collision/domain/entity"
 	"github.com/HMasataka/collision/domain/repository"
 	"github.com/HMasataka/errs"
-	"github.com/redis/rueidis"
-)
-
-const (
-	defaultAssignedDeleteTimeout = 1 * time.Minute
 )
 
 type AssignerService interface {
 	GetAssignment(ctx context.Context, ticketID string) (*entity.Assignment, *errs.Error)
 	AssignTickets(ctx context.Context, asgs []*entity.AssignmentGroup) ([]string, *errs.Error)
+	SubscribeAssignment(ctx context.Context, ticketID string) (<-chan *entity.Assignment, func(), *errs.Error)
 }
 
 type assignerService struct {
-	client             rueidis.Client
-	ticketRepository   repository.TicketRepository
-	ticketIDRepository repository.TicketIDRepository
-	pendingRepository  repository.PendingTicketRepository
-	ticketService      TicketService
+	notifierDriver       driver.AssignmentNotifierDriver
+	ticketRepository     repository.TicketRepository
+	assignmentRepository repository.AssignmentRepository
+	ticketService        TicketService
+	eventSinkDriver      driver.EventSinkDriver
+	assignedTTL          time.Duration
 }
 
 func NewAssignerService(
-	client rueidis.Client,
+	notifierDriver driver.AssignmentNotifierDriver,
 	repositoryContainer *repository.RepositoryContainer,
 	ticketService TicketService,
+	eventSinkDriver driver.EventSinkDriver,
+	assignedTTL time.Duration,
 ) AssignerService {
 	return &assignerService{
-		client:             client,
-		ticketRepository:   repositoryContainer.TicketRepository,
-		ticketIDRepository: repositoryContainer.TicketIDRepository,
-		pendingRepository:  repositoryContainer.PendingTicketRepository,
-		ticketService:      ticketService,
+		notifierDriver:       notifierDriver,
+		ticketRepository:     repositoryContainer.TicketRepository,
+		assignmentRepository: repositoryContainer.AssignmentRepository,
+		ticketService:        ticketService,
+		eventSinkDriver:      eventSinkDriver,
+		assignedTTL:          assignedTTL,
 	}
 }
 
-func (s *assignerService) assignmentData(ticketID string) string {
-	return fmt.Sprintf("assign:%s", ticketID)
-}
-
 func (s *assignerService) GetAssignment(ctx context.Context, ticketID string) (*entity.Assignment, *errs.Error) {
-	query := s.client.B().Get().Key(s.assignmentData(ticketID)).Build()
-
-	resp := s.client.Do(ctx, query)
-	if err := resp.Error(); err != nil {
-		if rueidis.IsRedisNil(err) {
-			return nil, entity.ErrAssignmentNotFound
-		}
-		return nil, entity.ErrAssignmentGetFailed.WithCause(err)
-	}
-
-	data, err := resp.AsBytes()
-	if err != nil {
-		return nil, entity.ErrAssignmentGetFailed.WithCause(err)
-	}
-
-	var as entity.Assignment
-	if err := json.Unmarshal(data, &as); err != nil {
-		return nil, entity.ErrAssignmentDecodeFailed.WithCause(err)
-	}
-
-	return &as, nil
+	return s.assignmentRepository.Find(ctx, ticketID)
 }
 
 func (s *assignerService) AssignTickets(ctx context.Context, asgs []*entity.AssignmentGroup) ([]string, *errs.Error) {
 	var assignedTicketIDs, notAssignedTicketIDs []string
+	var events []*entity.TicketEvent
+	// The assignments stored before a failure are kept, so their events are emitted in any case.
+	defer func() {
+		emitTicketEvents(ctx, s.eventSinkDriver, events)
+	}()
+
+	now := time.Now()
 	for _, asg := range asgs {
 		if len(asg.TicketIds) == 0 {
 			continue
 		}
 		// set assignment to a tickets
-		redis := s.client
-
-		if err := s.setAssignmentToTickets(ctx, redis, asg.TicketIds, asg.Assignment); err != nil {
+		if err := s.assignmentRepository.Insert(ctx, asg.TicketIds, asg.Assignment, s.assignedTTL); err != nil {
 			notAssignedTicketIDs = append(notAssignedTicketIDs, asg.TicketIds...)
 			return notAssignedTicketIDs, err
 		}
 		assignedTicketIDs = append(assignedTicketIDs, asg.TicketIds...)
+
+		for _, event := range entity.NewTicketEvents(entity.TicketEventAssigned, asg.TicketIds, now) {
+			event.Assignment = asg.Assignment
+			events = append(events, event)
+		}
 	}
 	if len(assignedTicketIDs) > 0 {
 		// de-index assigned tickets
 		if err := s.ticketService.DeleteIndexTickets(ctx, assignedTicketIDs); err != nil {
-			return notAssignedTicketIDs, entity.ErrTicketDeindexFailed.WithCause(err)
+			return notAssignedTicketIDs, entity.WithCause(entity.ErrTicketDeindexFailed, err)
 		}
 
-		if err := s.setTicketsExpiration(ctx, assignedTicketIDs, defaultAssignedDeleteTimeout); err != nil {
+		if err := s.ticketRepository.Expire(ctx, assignedTicketIDs, s.assignedTTL); err != nil {
 			return notAssignedTicketIDs, err
 		}
 
-	}
-	return notAssignedTicketIDs, nil
-}
-
-func (s *assignerService) setAssignmentToTickets(ctx context.Context, redis rueidis.Client, ticketIDs []string, assignment *entity.Assignment) *errs.Error {
-	queries := make([]rueidis.Completed, len(ticketIDs))
-	for i, ticketID := range ticketIDs {
-		data, err := json.Marshal(assignment)
-		if err != nil {
-			return entity.ErrAssignmentEncodeFailed.WithCause(err)
-		}
-
-		queries[i] = redis.B().Set().
-			Key(s.assignmentData(ticketID)).
-			Value(rueidis.BinaryString(data)).
-			Ex(defaultAssignedDeleteTimeout).Build()
-	}
+		// notify watchers after the assignments are stored, so that they can also be read by GetAssignment.
+		// The notification is best effort for each group, as the assignments are already stored:
+		// the driver reports the failures, and the watchers that miss it read the assignment in their periodic check.
+		for _, asg := range asgs {
+			if len(asg.TicketIds) == 0 {
+				continue
+			}
 
-	for _, resp := range redis.DoMulti(ctx, queries...) {
-		if err := resp.Error(); err != nil {
-			return entity.ErrAssignmentSetFailed.WithCause(err)
+			_ = s.notifierDriver.Publish(ctx, asg)
 		}
 	}
-
-	return nil
+	return notAssignedTicketIDs, nil
 }
 
-func (s *assignerService) setTicketsExpiration(ctx context.Context, ticketIDs []string, expiration time.Duration) *errs.Error {
-	queries := make([]rueidis.Completed, len(ticketIDs))
-
-	for i, ticketID := range ticketIDs {
-		queries[i] = s.client.B().Expire().Key(s.ticketRepository.TicketDataKey(ticketID)).Seconds(int64(expiration.Seconds())).Build()
-	}
-
-	for _, resp := range s.client.DoMulti(ctx, queries...) {
-		if err := resp.Error(); err != nil {
-			return entity.ErrTicketExpirationFailed.WithCause(err)
-		}
-	}
-
-	return nil
+func (s *assignerService) SubscribeAssignment(ctx context.Context, ticketID string) (<-chan *entity.Assignment, func(), *errs.Error) {
+	return s.notifierDriver.Subscribe(ctx, ticketID)
 }
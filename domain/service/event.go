@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+
+	"github.com/HMasataka/collision/domain/driver"
+	"github.com/HMasataka/collision/domain/entity"
+)
+
+// emitTicketEvents emits the events after the tickets have changed. A failure is not returned,
+// as the change cannot be undone and the events are only for the consumers out of the server.
+// The sink reports the failure itself.
+func emitTicketEvents(ctx context.Context, eventSinkDriver driver.EventSinkDriver, events []*entity.TicketEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	_ = eventSinkDriver.Emit(ctx, events)
+}
@@ -2,15 +2,12 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"time"
 
 	"github.com/HMasataka/collision/domain/driver"
 	"github.com/HMasataka/collision/domain/entity"
 	"github.com/HMasataka/collision/domain/repository"
 	"github.com/HMasataka/errs"
-	"github.com/redis/rueidis"
-	"github.com/samber/lo"
 )
 
 type TicketService interface {
@@ -18,136 +15,131 @@ type TicketService interface {
 	Insert(ctx context.Context, target *entity.Ticket, ttl time.Duration) *errs.Error
 	DeleteTicket(ctx context.Context, ticketID string) *errs.Error
 	DeleteIndexTickets(ctx context.Context, ticketIDs []string) *errs.Error
+	DeleteExpiredTickets(ctx context.Context, ticketIDs []string) *errs.Error
+	ReleaseTickets(ctx context.Context, ticketIDs []string) *errs.Error
+	HoldTickets(ctx context.Context, ticketIDs []string) *errs.Error
+	NotifyMatches(ctx context.Context, matches entity.Matches)
+	CountTickets(ctx context.Context) (int64, int64, *errs.Error)
 }
 
 type ticketService struct {
-	client             rueidis.Client
-	lockerDriver       driver.LockerDriver
-	ticketRepository   repository.TicketRepository
-	ticketIDRepository repository.TicketIDRepository
-	pendingRepository  repository.PendingTicketRepository
+	ticketRepository        repository.TicketRepository
+	ticketIDRepository      repository.TicketIDRepository
+	pendingTicketRepository repository.PendingTicketRepository
+	ticketIndexRepository   repository.TicketIndexRepository
+	eventSinkDriver         driver.EventSinkDriver
 }
 
 func NewTicketService(
-	client rueidis.Client,
-	lockerDriver driver.LockerDriver,
 	repositoryContainer *repository.RepositoryContainer,
+	eventSinkDriver driver.EventSinkDriver,
 ) TicketService {
 	return &ticketService{
-		client:             client,
-		lockerDriver:       lockerDriver,
-		ticketRepository:   repositoryContainer.TicketRepository,
-		ticketIDRepository: repositoryContainer.TicketIDRepository,
-		pendingRepository:  repositoryContainer.PendingTicketRepository,
+		ticketRepository:        repositoryContainer.TicketRepository,
+		ticketIDRepository:      repositoryContainer.TicketIDRepository,
+		pendingTicketRepository: repositoryContainer.PendingTicketRepository,
+		ticketIndexRepository:   repositoryContainer.TicketIndexRepository,
+		eventSinkDriver:         eventSinkDriver,
 	}
 }
 
+// GetActiveTicketIDs fetches the tickets that are not pending and marks them as pending.
+// The tickets left pending past the pending release timeout are fetched and pended again without being released.
 func (s *ticketService) GetActiveTicketIDs(ctx context.Context, limit int64) ([]string, *errs.Error) {
-	// 複数のワーカーが同時にFetchしないようにロックを取得する
-	lockedCtx, unlock, err := s.lockerDriver.FetchTicketLock(ctx)
-	if err != nil {
-		return nil, err
-	}
-	defer unlock()
+	return s.ticketIndexRepository.FetchActiveTicketIDs(ctx, limit)
+}
 
-	allTicketIDs, err := s.ticketIDRepository.GetAllTicketIDs(lockedCtx, limit)
-	if err != nil {
-		return nil, entity.ErrIndexGetFailed.WithCause(err)
-	}
-	if len(allTicketIDs) == 0 {
-		return nil, nil
+func (s *ticketService) Insert(ctx context.Context, target *entity.Ticket, ttl time.Duration) *errs.Error {
+	if err := s.ticketRepository.Insert(ctx, target, ttl); err != nil {
+		return err
 	}
 
-	pendingTicketIDs, err := s.pendingRepository.GetPendingTicketIDs(lockedCtx)
-	if err != nil {
-		return nil, entity.ErrPendingTicketGetFailed.WithCause(err)
+	if err := s.ticketIDRepository.Insert(ctx, target.ID); err != nil {
+		return err
 	}
 
-	activeTicketIDs, _ := lo.Difference(allTicketIDs, pendingTicketIDs)
-	if len(activeTicketIDs) == 0 {
-		return nil, nil
-	}
+	s.emit(ctx, entity.NewTicketEvents(entity.TicketEventCreated, []string{target.ID}, target.CreatedAt))
 
-	if err := s.pendingRepository.InsertPendingTicket(lockedCtx, activeTicketIDs); err != nil {
-		return nil, entity.ErrPendingTicketSetFailed.WithCause(err)
-	}
+	return nil
+}
 
-	return activeTicketIDs, nil
+func (s *ticketService) DeleteIndexTickets(ctx context.Context, ticketIDs []string) *errs.Error {
+	return s.ticketIndexRepository.DeindexTickets(ctx, ticketIDs)
 }
 
-func (s *ticketService) Insert(ctx context.Context, target *entity.Ticket, ttl time.Duration) *errs.Error {
-	data, err := json.Marshal(target)
-	if err != nil {
-		return entity.ErrTicketMarshalFailed.WithCause(err)
+// DeleteExpiredTickets deindexes the tickets whose data has expired.
+// A ticket deleted by the client while it was being fetched is also reported as expired.
+func (s *ticketService) DeleteExpiredTickets(ctx context.Context, ticketIDs []string) *errs.Error {
+	if err := s.ticketIndexRepository.DeindexTickets(ctx, ticketIDs); err != nil {
+		return err
 	}
 
-	queries := []rueidis.Completed{
-		s.client.B().Set().
-			Key(s.ticketRepository.TicketDataKey(target.ID)).
-			Value(rueidis.BinaryString(data)).
-			Ex(ttl).
-			Build(),
-		s.client.B().Sadd().
-			Key(s.ticketIDRepository.TicketIDKey()).
-			Member(target.ID).
-			Build(),
-	}
+	s.emit(ctx, entity.NewTicketEvents(entity.TicketEventExpired, ticketIDs, time.Now()))
+
+	return nil
+}
+
+// ReleaseTickets removes the tickets from the pending tickets so that they can be fetched again.
+func (s *ticketService) ReleaseTickets(ctx context.Context, ticketIDs []string) *errs.Error {
+	return s.ticketIndexRepository.ReleaseTickets(ctx, ticketIDs)
+}
+
+// HoldTickets keeps the tickets pending past the pending release timeout, for example while they are held in a backfill.
+// The tickets that are no longer pending, because they have been assigned, deleted or released, are not pended again.
+func (s *ticketService) HoldTickets(ctx context.Context, ticketIDs []string) *errs.Error {
+	return s.pendingTicketRepository.RefreshPendingTickets(ctx, ticketIDs)
+}
 
-	for _, resp := range s.client.DoMulti(ctx, queries...) {
-		if err := resp.Error(); err != nil {
-			return entity.ErrTicketCreateFailed.WithCause(err)
+// NotifyMatches emits the matched events of the tickets in the matches.
+// The tickets stay pending until they are assigned or released.
+func (s *ticketService) NotifyMatches(ctx context.Context, matches entity.Matches) {
+	now := time.Now()
+
+	var events []*entity.TicketEvent
+	for _, match := range matches {
+		for _, event := range entity.NewTicketEvents(entity.TicketEventMatched, match.Tickets.IDs(), now) {
+			event.MatchID = match.MatchID
+			event.MatchProfile = match.MatchProfile
+			events = append(events, event)
 		}
 	}
 
-	return nil
+	s.emit(ctx, events)
 }
 
-func (s *ticketService) DeleteIndexTickets(ctx context.Context, ticketIDs []string) *errs.Error {
-	// Acquire locks to avoid race condition with GetActiveTicketIDs.
-	//
-	// Without locks, when the following order,
-	// The assigned ticket is fetched again by the other backend, resulting in overlapping matches.
-	//
-	// 1. (GetActiveTicketIDs) getAllTicketIDs
-	// 2. (deIndexTickets) ZREM and SREM from ticket index
-	// 3. (GetActiveTicketIDs) getPendingTicketIDs
-	lockedCtx, unlock, err := s.lockerDriver.FetchTicketLock(ctx)
-	if err != nil {
+func (s *ticketService) DeleteTicket(ctx context.Context, ticketID string) *errs.Error {
+	// The data is deleted first. A worker that fetches the ticket in between
+	// finds that the data is missing and deindexes it.
+	if err := s.ticketRepository.Delete(ctx, &entity.Ticket{ID: ticketID}); err != nil {
 		return err
 	}
-	defer unlock()
 
-	cmds := []rueidis.Completed{
-		s.client.B().Zrem().Key(s.pendingRepository.PendingTicketKey()).Member(ticketIDs...).Build(),
-		s.client.B().Srem().Key(s.ticketIDRepository.TicketIDKey()).Member(ticketIDs...).Build(),
+	if err := s.ticketIndexRepository.DeindexTickets(ctx, []string{ticketID}); err != nil {
+		return err
 	}
 
-	for _, resp := range s.client.DoMulti(lockedCtx, cmds...) {
-		if err := resp.Error(); err != nil {
-			return entity.ErrTicketDeindexFailed.WithCause(err)
-		}
-	}
+	s.emit(ctx, entity.NewTicketEvents(entity.TicketEventDeleted, []string{ticketID}, time.Now()))
 
 	return nil
 }
 
-func (s *ticketService) DeleteTicket(ctx context.Context, ticketID string) *errs.Error {
-	lockedCtx, unlock, err := s.lockerDriver.FetchTicketLock(ctx)
+// CountTickets returns the number of the indexed tickets that are not pending and that are pending.
+// The pending tickets may include the ones deleted but not deindexed yet, so the counts are approximate.
+func (s *ticketService) CountTickets(ctx context.Context) (int64, int64, *errs.Error) {
+	indexed, err := s.ticketIDRepository.CountTicketIDs(ctx)
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
-	defer unlock()
 
-	queries := []rueidis.Completed{
-		s.client.B().Del().Key(s.ticketRepository.TicketDataKey(ticketID)).Build(),
-		s.client.B().Srem().Key(s.ticketIDRepository.TicketIDKey()).Member(ticketID).Build(),
-		s.client.B().Zrem().Key(s.pendingRepository.PendingTicketKey()).Member(ticketID).Build(),
-	}
-	for _, resp := range s.client.DoMulti(lockedCtx, queries...) {
-		if err := resp.Error(); err != nil {
-			return entity.ErrTicketDeleteFailed.WithCause(err)
-		}
+	pending, err := s.pendingTicketRepository.CountPendingTickets(ctx)
+	if err != nil {
+		return 0, 0, err
 	}
 
-	return nil
+	return max(indexed-pending, 0), pending, nil
+}
+
+// emit sends the events of the tickets changed through the service.
+func (s *ticketService) emit(ctx context.Context, events []*entity.TicketEvent) {
+	emitTicketEvents(ctx, s.eventSinkDriver, events)
 }
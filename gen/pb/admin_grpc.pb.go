@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: admin.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	ListMatchProfiles(ctx context.Context, in *ListMatchProfilesRequest, opts ...grpc.CallOption) (*ListMatchProfilesResponse, error)
+	// PutMatchProfile adds the profile, or replaces the profile of the same name.
+	PutMatchProfile(ctx context.Context, in *PutMatchProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteMatchProfile(ctx context.Context, in *DeleteMatchProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ReloadMatchProfiles replaces all the profiles with the ones in the configured profiles file,
+	// discarding the profiles put or deleted with PutMatchProfile and DeleteMatchProfile.
+	ReloadMatchProfiles(ctx context.Context, in *ReloadMatchProfilesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) ListMatchProfiles(ctx context.Context, in *ListMatchProfilesRequest, opts ...grpc.CallOption) (*ListMatchProfilesResponse, error) {
+	out := new(ListMatchProfilesResponse)
+	err := c.cc.Invoke(ctx, "/openmatch.AdminService/ListMatchProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PutMatchProfile(ctx context.Context, in *PutMatchProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/openmatch.AdminService/PutMatchProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteMatchProfile(ctx context.Context, in *DeleteMatchProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/openmatch.AdminService/DeleteMatchProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReloadMatchProfiles(ctx context.Context, in *ReloadMatchProfilesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/openmatch.AdminService/ReloadMatchProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility
+type AdminServiceServer interface {
+	ListMatchProfiles(context.Context, *ListMatchProfilesRequest) (*ListMatchProfilesResponse, error)
+	// PutMatchProfile adds the profile, or replaces the profile of the same name.
+	PutMatchProfile(context.Context, *PutMatchProfileRequest) (*emptypb.Empty, error)
+	DeleteMatchProfile(context.Context, *DeleteMatchProfileRequest) (*emptypb.Empty, error)
+	// ReloadMatchProfiles replaces all the profiles with the ones in the configured profiles file,
+	// discarding the profiles put or deleted with PutMatchProfile and DeleteMatchProfile.
+	ReloadMatchProfiles(context.Context, *ReloadMatchProfilesRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (UnimplementedAdminServiceServer) ListMatchProfiles(context.Context, *ListMatchProfilesRequest) (*ListMatchProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMatchProfiles not implemented")
+}
+func (UnimplementedAdminServiceServer) PutMatchProfile(context.Context, *PutMatchProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutMatchProfile not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteMatchProfile(context.Context, *DeleteMatchProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMatchProfile not implemented")
+}
+func (UnimplementedAdminServiceServer) ReloadMatchProfiles(context.Context, *ReloadMatchProfilesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadMatchProfiles not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_ListMatchProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListMatchProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openmatch.AdminService/ListMatchProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListMatchProfiles(ctx, req.(*ListMatchProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PutMatchProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutMatchProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PutMatchProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openmatch.AdminService/PutMatchProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PutMatchProfile(ctx, req.(*PutMatchProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteMatchProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMatchProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteMatchProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openmatch.AdminService/DeleteMatchProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteMatchProfile(ctx, req.(*DeleteMatchProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReloadMatchProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadMatchProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReloadMatchProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openmatch.AdminService/ReloadMatchProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReloadMatchProfiles(ctx, req.(*ReloadMatchProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openmatch.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMatchProfiles",
+			Handler:    _AdminService_ListMatchProfiles_Handler,
+		},
+		{
+			MethodName: "PutMatchProfile",
+			Handler:    _AdminService_PutMatchProfile_Handler,
+		},
+		{
+			MethodName: "DeleteMatchProfile",
+			Handler:    _AdminService_DeleteMatchProfile_Handler,
+		},
+		{
+			MethodName: "ReloadMatchProfiles",
+			Handler:    _AdminService_ReloadMatchProfiles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
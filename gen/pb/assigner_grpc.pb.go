@@ -0,0 +1,105 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: assigner.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AssignerServiceClient is the client API for AssignerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AssignerServiceClient interface {
+	Assign(ctx context.Context, in *AssignRequest, opts ...grpc.CallOption) (*AssignResponse, error)
+}
+
+type assignerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAssignerServiceClient(cc grpc.ClientConnInterface) AssignerServiceClient {
+	return &assignerServiceClient{cc}
+}
+
+func (c *assignerServiceClient) Assign(ctx context.Context, in *AssignRequest, opts ...grpc.CallOption) (*AssignResponse, error) {
+	out := new(AssignResponse)
+	err := c.cc.Invoke(ctx, "/openmatch.AssignerService/Assign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AssignerServiceServer is the server API for AssignerService service.
+// All implementations must embed UnimplementedAssignerServiceServer
+// for forward compatibility
+type AssignerServiceServer interface {
+	Assign(context.Context, *AssignRequest) (*AssignResponse, error)
+	mustEmbedUnimplementedAssignerServiceServer()
+}
+
+// UnimplementedAssignerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAssignerServiceServer struct {
+}
+
+func (UnimplementedAssignerServiceServer) Assign(context.Context, *AssignRequest) (*AssignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Assign not implemented")
+}
+func (UnimplementedAssignerServiceServer) mustEmbedUnimplementedAssignerServiceServer() {}
+
+// UnsafeAssignerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AssignerServiceServer will
+// result in compilation errors.
+type UnsafeAssignerServiceServer interface {
+	mustEmbedUnimplementedAssignerServiceServer()
+}
+
+func RegisterAssignerServiceServer(s grpc.ServiceRegistrar, srv AssignerServiceServer) {
+	s.RegisterService(&AssignerService_ServiceDesc, srv)
+}
+
+func _AssignerService_Assign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignerServiceServer).Assign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openmatch.AssignerService/Assign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignerServiceServer).Assign(ctx, req.(*AssignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AssignerService_ServiceDesc is the grpc.ServiceDesc for AssignerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AssignerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openmatch.AssignerService",
+	HandlerType: (*AssignerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Assign",
+			Handler:    _AssignerService_Assign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "assigner.proto",
+}
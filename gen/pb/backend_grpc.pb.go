@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: backend.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BackendServiceClient is the client API for BackendService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BackendServiceClient interface {
+	FetchMatches(ctx context.Context, in *FetchMatchesRequest, opts ...grpc.CallOption) (BackendService_FetchMatchesClient, error)
+	AssignTickets(ctx context.Context, in *AssignTicketsRequest, opts ...grpc.CallOption) (*AssignTicketsResponse, error)
+}
+
+type backendServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBackendServiceClient(cc grpc.ClientConnInterface) BackendServiceClient {
+	return &backendServiceClient{cc}
+}
+
+func (c *backendServiceClient) FetchMatches(ctx context.Context, in *FetchMatchesRequest, opts ...grpc.CallOption) (BackendService_FetchMatchesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BackendService_ServiceDesc.Streams[0], "/openmatch.BackendService/FetchMatches", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &backendServiceFetchMatchesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BackendService_FetchMatchesClient interface {
+	Recv() (*FetchMatchesResponse, error)
+	grpc.ClientStream
+}
+
+type backendServiceFetchMatchesClient struct {
+	grpc.ClientStream
+}
+
+func (x *backendServiceFetchMatchesClient) Recv() (*FetchMatchesResponse, error) {
+	m := new(FetchMatchesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *backendServiceClient) AssignTickets(ctx context.Context, in *AssignTicketsRequest, opts ...grpc.CallOption) (*AssignTicketsResponse, error) {
+	out := new(AssignTicketsResponse)
+	err := c.cc.Invoke(ctx, "/openmatch.BackendService/AssignTickets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BackendServiceServer is the server API for BackendService service.
+// All implementations must embed UnimplementedBackendServiceServer
+// for forward compatibility
+type BackendServiceServer interface {
+	FetchMatches(*FetchMatchesRequest, BackendService_FetchMatchesServer) error
+	AssignTickets(context.Context, *AssignTicketsRequest) (*AssignTicketsResponse, error)
+	mustEmbedUnimplementedBackendServiceServer()
+}
+
+// UnimplementedBackendServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBackendServiceServer struct {
+}
+
+func (UnimplementedBackendServiceServer) FetchMatches(*FetchMatchesRequest, BackendService_FetchMatchesServer) error {
+	return status.Errorf(codes.Unimplemented, "method FetchMatches not implemented")
+}
+func (UnimplementedBackendServiceServer) AssignTickets(context.Context, *AssignTicketsRequest) (*AssignTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTickets not implemented")
+}
+func (UnimplementedBackendServiceServer) mustEmbedUnimplementedBackendServiceServer() {}
+
+// UnsafeBackendServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BackendServiceServer will
+// result in compilation errors.
+type UnsafeBackendServiceServer interface {
+	mustEmbedUnimplementedBackendServiceServer()
+}
+
+func RegisterBackendServiceServer(s grpc.ServiceRegistrar, srv BackendServiceServer) {
+	s.RegisterService(&BackendService_ServiceDesc, srv)
+}
+
+func _BackendService_FetchMatches_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FetchMatchesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BackendServiceServer).FetchMatches(m, &backendServiceFetchMatchesServer{stream})
+}
+
+type BackendService_FetchMatchesServer interface {
+	Send(*FetchMatchesResponse) error
+	grpc.ServerStream
+}
+
+type backendServiceFetchMatchesServer struct {
+	grpc.ServerStream
+}
+
+func (x *backendServiceFetchMatchesServer) Send(m *FetchMatchesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BackendService_AssignTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServiceServer).AssignTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openmatch.BackendService/AssignTickets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServiceServer).AssignTickets(ctx, req.(*AssignTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BackendService_ServiceDesc is the grpc.ServiceDesc for BackendService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BackendService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openmatch.BackendService",
+	HandlerType: (*BackendServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AssignTickets",
+			Handler:    _BackendService_AssignTickets_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "FetchMatches",
+			Handler:       _BackendService_FetchMatches_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "backend.proto",
+}
@@ -0,0 +1,105 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: evaluator.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EvaluatorServiceClient is the client API for EvaluatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EvaluatorServiceClient interface {
+	Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error)
+}
+
+type evaluatorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEvaluatorServiceClient(cc grpc.ClientConnInterface) EvaluatorServiceClient {
+	return &evaluatorServiceClient{cc}
+}
+
+func (c *evaluatorServiceClient) Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error) {
+	out := new(EvaluateResponse)
+	err := c.cc.Invoke(ctx, "/openmatch.EvaluatorService/Evaluate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EvaluatorServiceServer is the server API for EvaluatorService service.
+// All implementations must embed UnimplementedEvaluatorServiceServer
+// for forward compatibility
+type EvaluatorServiceServer interface {
+	Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error)
+	mustEmbedUnimplementedEvaluatorServiceServer()
+}
+
+// UnimplementedEvaluatorServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEvaluatorServiceServer struct {
+}
+
+func (UnimplementedEvaluatorServiceServer) Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+func (UnimplementedEvaluatorServiceServer) mustEmbedUnimplementedEvaluatorServiceServer() {}
+
+// UnsafeEvaluatorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EvaluatorServiceServer will
+// result in compilation errors.
+type UnsafeEvaluatorServiceServer interface {
+	mustEmbedUnimplementedEvaluatorServiceServer()
+}
+
+func RegisterEvaluatorServiceServer(s grpc.ServiceRegistrar, srv EvaluatorServiceServer) {
+	s.RegisterService(&EvaluatorService_ServiceDesc, srv)
+}
+
+func _EvaluatorService_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluatorServiceServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openmatch.EvaluatorService/Evaluate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluatorServiceServer).Evaluate(ctx, req.(*EvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EvaluatorService_ServiceDesc is the grpc.ServiceDesc for EvaluatorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EvaluatorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openmatch.EvaluatorService",
+	HandlerType: (*EvaluatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Evaluate",
+			Handler:    _EvaluatorService_Evaluate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evaluator.proto",
+}
@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: matchfunction.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// MatchFunctionServiceClient is the client API for MatchFunctionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MatchFunctionServiceClient interface {
+	// Run makes matches from the tickets in the pools and streams them.
+	// A match can only contain the tickets and the backfills given in the request.
+	Run(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (MatchFunctionService_RunClient, error)
+}
+
+type matchFunctionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMatchFunctionServiceClient(cc grpc.ClientConnInterface) MatchFunctionServiceClient {
+	return &matchFunctionServiceClient{cc}
+}
+
+func (c *matchFunctionServiceClient) Run(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (MatchFunctionService_RunClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MatchFunctionService_ServiceDesc.Streams[0], "/openmatch.MatchFunctionService/Run", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &matchFunctionServiceRunClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MatchFunctionService_RunClient interface {
+	Recv() (*RunResponse, error)
+	grpc.ClientStream
+}
+
+type matchFunctionServiceRunClient struct {
+	grpc.ClientStream
+}
+
+func (x *matchFunctionServiceRunClient) Recv() (*RunResponse, error) {
+	m := new(RunResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MatchFunctionServiceServer is the server API for MatchFunctionService service.
+// All implementations must embed UnimplementedMatchFunctionServiceServer
+// for forward compatibility
+type MatchFunctionServiceServer interface {
+	// Run makes matches from the tickets in the pools and streams them.
+	// A match can only contain the tickets and the backfills given in the request.
+	Run(*RunRequest, MatchFunctionService_RunServer) error
+	mustEmbedUnimplementedMatchFunctionServiceServer()
+}
+
+// UnimplementedMatchFunctionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMatchFunctionServiceServer struct {
+}
+
+func (UnimplementedMatchFunctionServiceServer) Run(*RunRequest, MatchFunctionService_RunServer) error {
+	return status.Errorf(codes.Unimplemented, "method Run not implemented")
+}
+func (UnimplementedMatchFunctionServiceServer) mustEmbedUnimplementedMatchFunctionServiceServer() {}
+
+// UnsafeMatchFunctionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MatchFunctionServiceServer will
+// result in compilation errors.
+type UnsafeMatchFunctionServiceServer interface {
+	mustEmbedUnimplementedMatchFunctionServiceServer()
+}
+
+func RegisterMatchFunctionServiceServer(s grpc.ServiceRegistrar, srv MatchFunctionServiceServer) {
+	s.RegisterService(&MatchFunctionService_ServiceDesc, srv)
+}
+
+func _MatchFunctionService_Run_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RunRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MatchFunctionServiceServer).Run(m, &matchFunctionServiceRunServer{stream})
+}
+
+type MatchFunctionService_RunServer interface {
+	Send(*RunResponse) error
+	grpc.ServerStream
+}
+
+type matchFunctionServiceRunServer struct {
+	grpc.ServerStream
+}
+
+func (x *matchFunctionServiceRunServer) Send(m *RunResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// MatchFunctionService_ServiceDesc is the grpc.ServiceDesc for MatchFunctionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MatchFunctionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openmatch.MatchFunctionService",
+	HandlerType: (*MatchFunctionServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Run",
+			Handler:       _MatchFunctionService_Run_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "matchfunction.proto",
+}
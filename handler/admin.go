@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/HMasataka/collision/conv"
+	"github.com/HMasataka/collision/gen/pb"
+	"github.com/HMasataka/collision/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type Admin struct {
+	profileUsecase usecase.ProfileUsecase
+
+	pb.UnimplementedAdminServiceServer
+}
+
+func NewAdmin(
+	profileUsecase usecase.ProfileUsecase,
+) *Admin {
+	return &Admin{
+		profileUsecase: profileUsecase,
+	}
+}
+
+func (h Admin) ListMatchProfiles(ctx context.Context, req *pb.ListMatchProfilesRequest) (*pb.ListMatchProfilesResponse, error) {
+	profiles := h.profileUsecase.ListMatchProfiles(ctx)
+
+	res := &pb.ListMatchProfilesResponse{
+		Profiles:       make([]*pb.MatchProfile, 0, len(profiles)),
+		MatchFunctions: make(map[string]string, len(profiles)),
+	}
+	for _, profile := range profiles {
+		res.Profiles = append(res.Profiles, conv.ToPbMatchProfile(profile))
+		res.MatchFunctions[profile.Name] = profile.MatchFunction
+	}
+
+	return res, nil
+}
+
+func (h Admin) PutMatchProfile(ctx context.Context, req *pb.PutMatchProfileRequest) (*emptypb.Empty, error) {
+	if req.GetProfile() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "profile is required")
+	}
+
+	profile := conv.ToMatchProfile(req.GetProfile())
+	if err := h.profileUsecase.PutMatchProfile(ctx, profile, req.GetMatchFunction()); err != nil {
+		return nil, toStatusError(err, "failed to put match profile "+profile.Name)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h Admin) DeleteMatchProfile(ctx context.Context, req *pb.DeleteMatchProfileRequest) (*emptypb.Empty, error) {
+	name := req.GetName()
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+
+	if err := h.profileUsecase.DeleteMatchProfile(ctx, name); err != nil {
+		return nil, toStatusError(err, "failed to delete match profile "+name)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h Admin) ReloadMatchProfiles(ctx context.Context, req *pb.ReloadMatchProfilesRequest) (*emptypb.Empty, error) {
+	if err := h.profileUsecase.ReloadMatchProfiles(ctx); err != nil {
+		return nil, toStatusError(err, "failed to reload match profiles")
+	}
+
+	return &emptypb.Empty{}, nil
+}
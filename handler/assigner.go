@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/HMasataka/collision/conv"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/gen/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Assigner serves an assigner as the AssignerService,
+// so that it can be run out of the server and referred to by match.assigner in the config.
+type Assigner struct {
+	assigner entity.Assigner
+
+	pb.UnimplementedAssignerServiceServer
+}
+
+func NewAssigner(
+	assigner entity.Assigner,
+) *Assigner {
+	return &Assigner{
+		assigner: assigner,
+	}
+}
+
+func (h Assigner) Assign(ctx context.Context, req *pb.AssignRequest) (*pb.AssignResponse, error) {
+	matches := make(entity.Matches, 0, len(req.GetMatches()))
+	for _, match := range req.GetMatches() {
+		matches = append(matches, conv.ToMatch(match))
+	}
+
+	asgs, err := h.assigner.Assign(ctx, matches)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to assign matches: %v", err)
+	}
+
+	return &pb.AssignResponse{
+		Assignments: conv.ToPbAssignmentGroups(asgs),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/HMasataka/collision/conv"
+	"github.com/HMasataka/collision/gen/pb"
+	"github.com/HMasataka/collision/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Backend struct {
+	matchUsecase usecase.MatchUsecase
+
+	pb.UnimplementedBackendServiceServer
+}
+
+func NewBackend(
+	matchUsecase usecase.MatchUsecase,
+) *Backend {
+	return &Backend{
+		matchUsecase: matchUsecase,
+	}
+}
+
+func (h Backend) FetchMatches(req *pb.FetchMatchesRequest, stream pb.BackendService_FetchMatchesServer) error {
+	profile := conv.ToMatchProfile(req.GetProfile())
+	if profile == nil || profile.Name == "" {
+		return status.Errorf(codes.InvalidArgument, "profile name is required")
+	}
+
+	matches, err := h.matchUsecase.FetchMatches(stream.Context(), profile)
+	if err != nil {
+		return toStatusError(err, "failed to fetch matches for profile "+profile.Name)
+	}
+
+	for _, match := range matches {
+		if err := stream.Send(&pb.FetchMatchesResponse{
+			Match: conv.ToPbMatch(match),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h Backend) AssignTickets(ctx context.Context, req *pb.AssignTicketsRequest) (*pb.AssignTicketsResponse, error) {
+	asgs := conv.ToAssignmentGroups(req.GetAssignments())
+	for _, asg := range asgs {
+		if asg.Assignment == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "assignment is required")
+		}
+	}
+
+	notAssigned, err := h.matchUsecase.AssignTickets(ctx, asgs)
+	if err != nil {
+		return nil, toStatusError(err, "failed to assign tickets")
+	}
+
+	return &pb.AssignTicketsResponse{
+		FailedTicketIds: notAssigned,
+	}, nil
+}
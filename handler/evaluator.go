@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/HMasataka/collision/conv"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/gen/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Evaluator serves an evaluator as the EvaluatorService,
+// so that it can be run out of the server and referred to by match.evaluator in the config.
+type Evaluator struct {
+	evaluator entity.Evaluator
+
+	pb.UnimplementedEvaluatorServiceServer
+}
+
+func NewEvaluator(
+	evaluator entity.Evaluator,
+) *Evaluator {
+	return &Evaluator{
+		evaluator: evaluator,
+	}
+}
+
+func (h Evaluator) Evaluate(ctx context.Context, req *pb.EvaluateRequest) (*pb.EvaluateResponse, error) {
+	matches := make([]*entity.Match, 0, len(req.GetMatches()))
+	for _, match := range req.GetMatches() {
+		matches = append(matches, conv.ToMatch(match))
+	}
+
+	matchIDs, err := h.evaluator.Evaluate(ctx, matches)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to evaluate matches: %v", err)
+	}
+
+	return &pb.EvaluateResponse{
+		MatchIds: matchIDs,
+	}, nil
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 
+	"github.com/HMasataka/collision/conv"
 	"github.com/HMasataka/collision/domain/entity"
 	"github.com/HMasataka/collision/gen/pb"
 	"github.com/HMasataka/collision/usecase"
@@ -13,8 +14,9 @@ import (
 )
 
 type Frontend struct {
-	ticketUsecase usecase.TicketUsecase
-	assignUsecase usecase.AssignUsecase
+	ticketUsecase   usecase.TicketUsecase
+	assignUsecase   usecase.AssignUsecase
+	backfillUsecase usecase.BackfillUsecase
 
 	pb.UnimplementedFrontendServiceServer
 }
@@ -22,20 +24,23 @@ type Frontend struct {
 func NewFrontend(
 	ticketUsecase usecase.TicketUsecase,
 	assignUsecase usecase.AssignUsecase,
+	backfillUsecase usecase.BackfillUsecase,
 ) *Frontend {
 	return &Frontend{
-		ticketUsecase: ticketUsecase,
-		assignUsecase: assignUsecase,
+		ticketUsecase:   ticketUsecase,
+		assignUsecase:   assignUsecase,
+		backfillUsecase: backfillUsecase,
 	}
 }
 
 func (h Frontend) CreateTicket(ctx context.Context, req *pb.CreateTicketRequest) (*pb.CreateTicketResponse, error) {
-	searchFields := ToSearchFields(req.GetSearchFields())
+	searchFields := conv.ToSearchFields(req.GetSearchFields())
 
-	res, err := h.ticketUsecase.CreateTicket(ctx, searchFields, req.GetExtensions())
+	res, err := h.ticketUsecase.CreateTicket(ctx, searchFields, req.GetMembers(), req.GetExtensions())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create ticket: %v", err)
+		return nil, toStatusError(err, "failed to create ticket")
 	}
+	annotateTicket(ctx, res.ID)
 
 	return &pb.CreateTicketResponse{
 		Id:         res.ID,
@@ -45,42 +50,138 @@ func (h Frontend) CreateTicket(ctx context.Context, req *pb.CreateTicketRequest)
 
 func (h Frontend) DeleteTicket(ctx context.Context, req *pb.DeleteTicketRequest) (*emptypb.Empty, error) {
 	id := req.GetTicketId()
+	if id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "ticket_id is required")
+	}
+	annotateTicket(ctx, id)
 
-	err := h.ticketUsecase.DeleteTicket(ctx, id)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete ticket: %v", err)
+	if err := h.ticketUsecase.DeleteTicket(ctx, id); err != nil {
+		return nil, toStatusError(err, "failed to delete ticket")
 	}
 
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTicket not implemented")
+	return &emptypb.Empty{}, nil
 }
 
-func (Frontend) GetTicket(context.Context, *pb.GetTicketRequest) (*pb.Ticket, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTicket not implemented")
+func (h Frontend) GetTicket(ctx context.Context, req *pb.GetTicketRequest) (*pb.Ticket, error) {
+	id := req.GetTicketId()
+	if id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "ticket_id is required")
+	}
+	annotateTicket(ctx, id)
+
+	ticket, err := h.ticketUsecase.GetTicket(ctx, id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get ticket")
+	}
+
+	return conv.ToPbTicket(ticket), nil
 }
 
 func (h Frontend) WatchAssignments(req *pb.WatchAssignmentsRequest, stream pb.FrontendService_WatchAssignmentsServer) error {
 	ticketID := req.GetTicketId()
+	if ticketID == "" {
+		return status.Errorf(codes.InvalidArgument, "ticket_id is required")
+	}
+	annotateTicket(stream.Context(), ticketID)
 
-	if err := h.assignUsecase.Watch(stream.Context(), ticketID, func(assignment *entity.Assignment) error {
+	if err := h.assignUsecase.Watch(stream.Context(), ticketID, req.GetOneShot(), func(assignment *entity.Assignment) error {
 		if assignment == nil {
 			return nil
 		}
 
-		pbAssignment := &pb.Assignment{
-			Connection: assignment.Connection,
-			Extensions: assignment.Extensions,
-		}
-
 		if err := stream.Send(&pb.WatchAssignmentsResponse{
-			Assignment: pbAssignment,
+			Assignment: conv.ToPbAssignment(assignment),
 		}); err != nil {
 			return err
 		}
 
 		return nil
 	}); err != nil {
-		return status.Errorf(codes.Internal, "failed to watch assignments: %v", err)
+		return toStatusError(err, "failed to watch assignments")
 	}
 
 	return nil
 }
+
+func (h Frontend) CreateBackfill(ctx context.Context, req *pb.CreateBackfillRequest) (*pb.Backfill, error) {
+	if req.GetBackfill() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "backfill is required")
+	}
+
+	backfill, err := h.backfillUsecase.CreateBackfill(ctx, conv.ToSearchFields(req.GetBackfill().GetSearchFields()), req.GetBackfill().GetExtensions())
+	if err != nil {
+		return nil, toStatusError(err, "failed to create backfill")
+	}
+	annotateBackfill(ctx, backfill.ID)
+
+	return conv.ToPbBackfill(backfill), nil
+}
+
+func (h Frontend) GetBackfill(ctx context.Context, req *pb.GetBackfillRequest) (*pb.Backfill, error) {
+	id := req.GetBackfillId()
+	if id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "backfill_id is required")
+	}
+	annotateBackfill(ctx, id)
+
+	backfill, err := h.backfillUsecase.GetBackfill(ctx, id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get backfill")
+	}
+
+	return conv.ToPbBackfill(backfill), nil
+}
+
+func (h Frontend) UpdateBackfill(ctx context.Context, req *pb.UpdateBackfillRequest) (*pb.Backfill, error) {
+	id := req.GetBackfill().GetId()
+	if id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "backfill.id is required")
+	}
+	annotateBackfill(ctx, id)
+
+	backfill, err := h.backfillUsecase.UpdateBackfill(ctx, id, conv.ToSearchFields(req.GetBackfill().GetSearchFields()), req.GetBackfill().GetExtensions())
+	if err != nil {
+		return nil, toStatusError(err, "failed to update backfill")
+	}
+
+	return conv.ToPbBackfill(backfill), nil
+}
+
+func (h Frontend) DeleteBackfill(ctx context.Context, req *pb.DeleteBackfillRequest) (*emptypb.Empty, error) {
+	id := req.GetBackfillId()
+	if id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "backfill_id is required")
+	}
+	annotateBackfill(ctx, id)
+
+	if err := h.backfillUsecase.DeleteBackfill(ctx, id); err != nil {
+		return nil, toStatusError(err, "failed to delete backfill")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h Frontend) AcknowledgeBackfill(ctx context.Context, req *pb.AcknowledgeBackfillRequest) (*pb.AcknowledgeBackfillResponse, error) {
+	id := req.GetBackfillId()
+	if id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "backfill_id is required")
+	}
+	annotateBackfill(ctx, id)
+	if req.GetAssignment().GetConnection() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "assignment.connection is required")
+	}
+
+	backfill, tickets, err := h.backfillUsecase.AcknowledgeBackfill(ctx, id, conv.ToAssignment(req.GetAssignment()))
+	if err != nil {
+		return nil, toStatusError(err, "failed to acknowledge backfill")
+	}
+
+	res := &pb.AcknowledgeBackfillResponse{
+		Backfill: conv.ToPbBackfill(backfill),
+	}
+	for _, ticket := range tickets {
+		res.Tickets = append(res.Tickets, conv.ToPbTicket(ticket))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/gen/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const maxGatewayRequestBytes = 1 << 20
+
+// Gateway serves the tickets of the FrontendService as HTTP/JSON for the clients that cannot use gRPC.
+// The bodies are the messages of the FrontendService in the protojson format,
+// and WatchAssignments is delivered as Server-Sent Events.
+// It also serves the WebSocket that creates a ticket and watches its assignments on /v1/ws.
+type Gateway struct {
+	frontend *Frontend
+	mux      *http.ServeMux
+	handler  http.Handler
+}
+
+func NewGateway(
+	frontend *Frontend,
+	webSocket *WebSocket,
+	logger *slog.Logger,
+) *Gateway {
+	h := &Gateway{
+		frontend: frontend,
+		mux:      http.NewServeMux(),
+	}
+	h.handler = logRequests(logger, h.mux)
+
+	h.mux.HandleFunc("POST /v1/tickets", h.createTicket)
+	h.mux.HandleFunc("GET /v1/tickets/{ticket_id}", h.getTicket)
+	h.mux.HandleFunc("DELETE /v1/tickets/{ticket_id}", h.deleteTicket)
+	h.mux.HandleFunc("GET /v1/tickets/{ticket_id}/assignments", h.watchAssignments)
+	h.mux.Handle("GET /v1/ws", webSocket)
+
+	return h
+}
+
+func (h *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.handler.ServeHTTP(w, r)
+}
+
+func (h *Gateway) createTicket(w http.ResponseWriter, r *http.Request) {
+	req := &pb.CreateTicketRequest{}
+	if err := readMessage(w, r, req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	res, err := h.frontend.CreateTicket(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeMessage(w, res)
+}
+
+func (h *Gateway) getTicket(w http.ResponseWriter, r *http.Request) {
+	res, err := h.frontend.GetTicket(r.Context(), &pb.GetTicketRequest{TicketId: r.PathValue("ticket_id")})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeMessage(w, res)
+}
+
+func (h *Gateway) deleteTicket(w http.ResponseWriter, r *http.Request) {
+	res, err := h.frontend.DeleteTicket(r.Context(), &pb.DeleteTicketRequest{TicketId: r.PathValue("ticket_id")})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeMessage(w, res)
+}
+
+// watchAssignments streams the assignments of the ticket as the data of the events.
+// The ticket is looked up before the stream starts, so that an invalid or unknown ticket gets the status code.
+// The errors after the stream has started are sent as the error events,
+// as the status code has already been written.
+func (h *Gateway) watchAssignments(w http.ResponseWriter, r *http.Request) {
+	req := &pb.WatchAssignmentsRequest{TicketId: r.PathValue("ticket_id")}
+	if v := r.URL.Query().Get("oneShot"); v != "" {
+		oneShot, err := strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, status.Errorf(codes.InvalidArgument, "oneShot must be a boolean: %v", err))
+			return
+		}
+		req.OneShot = oneShot
+	}
+
+	if _, err := h.frontend.GetTicket(r.Context(), &pb.GetTicketRequest{TicketId: req.GetTicketId()}); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Proxies such as nginx would otherwise buffer the events.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	stream := &sseStream{
+		ctx: r.Context(),
+		w:   w,
+		rc:  http.NewResponseController(w),
+	}
+	if err := stream.rc.Flush(); err != nil {
+		return
+	}
+
+	if err := h.frontend.WatchAssignments(req, stream); err != nil {
+		_ = stream.writeEvent("error", status.Convert(err).Proto())
+	}
+}
+
+// sseStream sends the responses of WatchAssignments as Server-Sent Events.
+// The handler only calls Context and Send, so the other methods of grpc.ServerStream are not implemented.
+type sseStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+	w   http.ResponseWriter
+	rc  *http.ResponseController
+}
+
+func (s *sseStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sseStream) Send(res *pb.WatchAssignmentsResponse) error {
+	return s.writeEvent("", res)
+}
+
+func (s *sseStream) writeEvent(event string, m proto.Message) error {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	if event != "" {
+		if _, err := fmt.Fprintf(s.w, "event: %s\n", event); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(s.w, "data: %s\n\n", data); err != nil {
+		return err
+	}
+
+	return s.rc.Flush()
+}
+
+// readMessage decodes the JSON body into the message. An empty body is an empty message.
+func readMessage(w http.ResponseWriter, r *http.Request, m proto.Message) error {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxGatewayRequestBytes))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to read request body: %v", err)
+	}
+	if len(body) == 0 {
+		return nil
+	}
+
+	if err := protojson.Unmarshal(body, m); err != nil {
+		return toStatusError(entity.WithCause(entity.ErrRequestDecodeFailed, err), "invalid request body")
+	}
+
+	return nil
+}
+
+func writeMessage(w http.ResponseWriter, m proto.Message) {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		writeError(w, status.Errorf(codes.Internal, "failed to marshal response: %v", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// writeError writes the gRPC status of the error as the JSON body, with the corresponding HTTP status code.
+func writeError(w http.ResponseWriter, err error) {
+	s := status.Convert(err)
+
+	data, merr := protojson.Marshal(s.Proto())
+	if merr != nil {
+		http.Error(w, s.Message(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatusCode(s.Code()))
+	_, _ = w.Write(data)
+}
+
+func httpStatusCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		// 499 Client Closed Request, which net/http does not define.
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/HMasataka/collision/logging"
+	"github.com/HMasataka/collision/tracing"
+	"github.com/rs/xid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const requestIDHeader = "x-request-id"
+
+// NewUnaryLoggingInterceptor logs each call with the request ID taken from the x-request-id metadata or generated.
+// The logs written while handling the call carry the request ID and the IDs annotated by the handlers.
+func NewUnaryLoggingInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx = newRequestContext(ctx, info.FullMethod)
+		start := time.Now()
+
+		res, err := handler(ctx, req)
+		logCall(ctx, logger, start, err)
+
+		return res, err
+	}
+}
+
+// NewStreamLoggingInterceptor is NewUnaryLoggingInterceptor for the streams, which are logged when they end.
+func NewStreamLoggingInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := newRequestContext(stream.Context(), info.FullMethod)
+		start := time.Now()
+
+		err := handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+		logCall(ctx, logger, start, err)
+
+		return err
+	}
+}
+
+type contextStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func newRequestContext(ctx context.Context, method string) context.Context {
+	requestID := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDHeader); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	if requestID == "" {
+		requestID = xid.New().String()
+	}
+
+	return logging.NewContext(ctx, logging.RequestID(requestID), slog.String("method", method))
+}
+
+func logCall(ctx context.Context, logger *slog.Logger, start time.Time, err error) {
+	code := status.Code(err)
+
+	level := slog.LevelDebug
+	switch code {
+	case codes.OK:
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		level = slog.LevelError
+	default:
+		level = slog.LevelWarn
+	}
+
+	attrs := []slog.Attr{
+		slog.String("code", code.String()),
+		slog.Duration("duration", time.Since(start)),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", status.Convert(err).Message()))
+	}
+
+	logger.LogAttrs(ctx, level, "handled request", attrs...)
+}
+
+// annotateTicket adds the ticket ID to the span and the logs of the request.
+func annotateTicket(ctx context.Context, ticketID string) {
+	tracing.SetAttributes(ctx, tracing.TicketID(ticketID))
+	logging.AddAttrs(ctx, logging.TicketID(ticketID))
+}
+
+// annotateBackfill adds the backfill ID to the span and the logs of the request.
+func annotateBackfill(ctx context.Context, backfillID string) {
+	tracing.SetAttributes(ctx, tracing.BackfillID(backfillID))
+	logging.AddAttrs(ctx, logging.BackfillID(backfillID))
+}
+
+// logRequests is the logging of the HTTP requests, with the request ID taken from the X-Request-Id header or generated.
+func logRequests(logger *slog.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(requestIDHeader)
+		if requestID == "" {
+			requestID = xid.New().String()
+		}
+
+		ctx := logging.NewContext(r.Context(), logging.RequestID(requestID), slog.String("method", r.Method), slog.String("path", r.URL.Path))
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+
+		next.ServeHTTP(rec, r.WithContext(ctx))
+
+		level := slog.LevelDebug
+		switch {
+		case rec.status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case rec.status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+
+		logger.LogAttrs(ctx, level, "handled request",
+			slog.Int("status", rec.status),
+			slog.Duration("duration", time.Since(start)),
+		)
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+
+	status int
+	wrote  bool
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wrote {
+		r.status = status
+		r.wrote = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap lets http.ResponseController flush the events and hijack the WebSocket connections.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
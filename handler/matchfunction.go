@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"github.com/HMasataka/collision/conv"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/gen/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MatchFunction serves a match function as the MatchFunctionService,
+// so that it can be run out of the server and referred to by match.functions in the config.
+type MatchFunction struct {
+	matchFunction entity.MatchFunction
+
+	pb.UnimplementedMatchFunctionServiceServer
+}
+
+func NewMatchFunction(
+	matchFunction entity.MatchFunction,
+) *MatchFunction {
+	return &MatchFunction{
+		matchFunction: matchFunction,
+	}
+}
+
+func (h MatchFunction) Run(req *pb.RunRequest, stream pb.MatchFunctionService_RunServer) error {
+	profile := conv.ToMatchProfile(req.GetProfile())
+	if profile == nil {
+		return status.Errorf(codes.InvalidArgument, "profile is required")
+	}
+
+	poolTickets := make(map[string]entity.Tickets, len(req.GetPools()))
+	poolBackfills := make(map[string]entity.Backfills, len(req.GetPools()))
+	for _, pool := range req.GetPools() {
+		tickets := make(entity.Tickets, 0, len(pool.GetTickets()))
+		for _, ticket := range pool.GetTickets() {
+			tickets = append(tickets, conv.ToTicket(ticket))
+		}
+		poolTickets[pool.GetPool()] = tickets
+
+		for _, backfill := range pool.GetBackfills() {
+			poolBackfills[pool.GetPool()] = append(poolBackfills[pool.GetPool()], conv.ToBackfill(backfill))
+		}
+	}
+
+	matches, err := h.matchFunction.MakeMatches(stream.Context(), profile, poolTickets, poolBackfills)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to make matches: %v", err)
+	}
+
+	for _, match := range matches {
+		if err := stream.Send(&pb.RunResponse{
+			Match: conv.ToPbMatch(match),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusCodes maps the IDs of the sentinel errors to the codes, as the errors in the chain are new errors of the sentinels.
+var statusCodes = map[string]codes.Code{
+	entity.ErrTicketNotFound.ID():             codes.NotFound,
+	entity.ErrTicketGone.ID():                 codes.Aborted,
+	entity.ErrTicketInvalid.ID():              codes.InvalidArgument,
+	entity.ErrAssignmentNotFound.ID():         codes.NotFound,
+	entity.ErrBackfillNotFound.ID():           codes.NotFound,
+	entity.ErrBackfillGenerationMismatch.ID(): codes.Aborted,
+	entity.ErrMatchFunctionNotFound.ID():      codes.NotFound,
+	entity.ErrMatchProfileNotFound.ID():       codes.NotFound,
+	entity.ErrMatchProfileInvalid.ID():        codes.InvalidArgument,
+	entity.ErrMatchProfileLoadFailed.ID():     codes.FailedPrecondition,
+	entity.ErrMatchProfilesWatched.ID():       codes.FailedPrecondition,
+	entity.ErrLockAcquisitionFailed.ID():      codes.Unavailable,
+	entity.ErrRequestDecodeFailed.ID():        codes.InvalidArgument,
+	// The stored data that cannot be decoded is a fault of the server, not of the request.
+	entity.ErrAssignmentDecodeFailed.ID():  codes.Internal,
+	entity.ErrTicketUnmarshalFailed.ID():   codes.Internal,
+	entity.ErrBackfillUnmarshalFailed.ID(): codes.Internal,
+	entity.ErrIndexDecodeFailed.ID():       codes.Internal,
+}
+
+// toStatusError converts an error returned from the usecases into a gRPC status error.
+// The code is taken from the outermost error in the chain that has a known mapping, and Internal is used otherwise.
+func toStatusError(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+
+	return status.Error(statusCode(err), msg+": "+describe(err))
+}
+
+func statusCode(err error) codes.Code {
+	code := codes.Internal
+
+	entity.WalkError(err, func(e error) bool {
+		if s, ok := e.(interface{ GRPCStatus() *status.Status }); ok {
+			code = s.GRPCStatus().Code()
+			return false
+		}
+
+		if e, ok := e.(*errs.Error); ok {
+			if c, ok := statusCodes[e.ID()]; ok {
+				code = c
+				return false
+			}
+		}
+
+		switch e {
+		case context.Canceled:
+			code = codes.Canceled
+			return false
+		case context.DeadlineExceeded:
+			code = codes.DeadlineExceeded
+			return false
+		}
+
+		return true
+	})
+
+	return code
+}
+
+// describe joins the messages of the error chain.
+func describe(err error) string {
+	return strings.Join(entity.ErrorMessages(err), ": ")
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{
+			name: "sentinel",
+			err:  entity.ErrTicketNotFound,
+			want: codes.NotFound,
+		},
+		{
+			name: "new error of the sentinel",
+			err:  entity.WithCause(entity.ErrLockAcquisitionFailed, errors.New("busy")),
+			want: codes.Unavailable,
+		},
+		{
+			name: "outermost mapping",
+			err:  entity.WithCause(entity.ErrTicketInvalid, entity.ErrTicketNotFound),
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "cause of an unmapped error",
+			err:  entity.WithCause(entity.ErrAssignmentWatchFailed, entity.ErrTicketNotFound),
+			want: codes.NotFound,
+		},
+		{
+			name: "request that cannot be decoded",
+			err:  entity.WithCause(entity.ErrRequestDecodeFailed, errors.New("unexpected token")),
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "stored data that cannot be decoded",
+			err:  entity.WithCause(entity.ErrAssignmentWatchFailed, entity.WithCause(entity.ErrTicketUnmarshalFailed, errors.New("unexpected EOF"))),
+			want: codes.Internal,
+		},
+		{
+			name: "status of a remote call",
+			err:  entity.WithCause(entity.ErrRemoteEvaluatorFailed, status.Error(codes.Unavailable, "down")),
+			want: codes.Unavailable,
+		},
+		{
+			name: "context",
+			err:  entity.WithCause(entity.ErrAssignmentWatchFailed, fmt.Errorf("wait: %w", context.Canceled)),
+			want: codes.Canceled,
+		},
+		{
+			name: "unknown",
+			err:  errors.New("boom"),
+			want: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statusCode(tt.err); got != tt.want {
+				t.Errorf("statusCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
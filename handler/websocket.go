@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/HMasataka/collision/conv"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/gen/pb"
+	"github.com/HMasataka/collision/logging"
+	"github.com/HMasataka/collision/usecase"
+	"github.com/coder/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	webSocketCreateTimeout = 10 * time.Second
+	// webSocketStatusBase is added to the gRPC code to make the close status,
+	// which is in the range reserved for the applications.
+	webSocketStatusBase = 4000
+	// The close reason must fit in a control frame.
+	maxWebSocketCloseReason = 123
+)
+
+// WebSocket creates a ticket and streams its assignments over a WebSocket,
+// for the browser clients that cannot use gRPC.
+//
+// The client sends a CreateTicketRequest as the first message, and receives a CreateTicketResponse
+// followed by a WatchAssignmentsResponse for each assignment, all in the protojson format.
+// The socket is closed with 4000 + the gRPC code on errors.
+// The ticket is deleted if the socket ends before an assignment is delivered, as the player has left.
+type WebSocket struct {
+	ticketUsecase usecase.TicketUsecase
+	assignUsecase usecase.AssignUsecase
+	// originPatterns are the host patterns of the origins allowed in addition to the same origin.
+	originPatterns []string
+	logger         *slog.Logger
+}
+
+func NewWebSocket(
+	ticketUsecase usecase.TicketUsecase,
+	assignUsecase usecase.AssignUsecase,
+	originPatterns []string,
+	logger *slog.Logger,
+) *WebSocket {
+	return &WebSocket{
+		ticketUsecase:  ticketUsecase,
+		assignUsecase:  assignUsecase,
+		originPatterns: originPatterns,
+		logger:         logger,
+	}
+}
+
+func (h *WebSocket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	oneShot := false
+	if v := r.URL.Query().Get("oneShot"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, status.Errorf(codes.InvalidArgument, "oneShot must be a boolean: %v", err))
+			return
+		}
+		oneShot = b
+	}
+
+	c, err := websocket.Accept(w, r, &websocket.AcceptOptions{OriginPatterns: h.originPatterns})
+	if err != nil {
+		return
+	}
+	defer c.CloseNow()
+
+	if err := h.serve(r.Context(), c, oneShot); err != nil {
+		s := status.Convert(err)
+		c.Close(websocket.StatusCode(webSocketStatusBase+int(s.Code())), truncate(s.Message(), maxWebSocketCloseReason))
+		return
+	}
+
+	c.Close(websocket.StatusNormalClosure, "")
+}
+
+func (h *WebSocket) serve(ctx context.Context, c *websocket.Conn, oneShot bool) error {
+	req := &pb.CreateTicketRequest{}
+	if err := readWebSocketMessage(ctx, c, req); err != nil {
+		return err
+	}
+
+	ticket, err := h.ticketUsecase.CreateTicket(ctx, conv.ToSearchFields(req.GetSearchFields()), req.GetMembers(), req.GetExtensions())
+	if err != nil {
+		return toStatusError(err, "failed to create ticket")
+	}
+	annotateTicket(ctx, ticket.ID)
+
+	delivered := false
+	defer func() {
+		if !delivered {
+			h.deleteTicket(ctx, ticket.ID)
+		}
+	}()
+
+	if err := writeWebSocketMessage(ctx, c, &pb.CreateTicketResponse{
+		Id:         ticket.ID,
+		CreateTime: timestamppb.New(ticket.CreatedAt),
+	}); err != nil {
+		return err
+	}
+
+	// The client sends nothing after the request, so reading only detects that the socket has been closed.
+	ctx = c.CloseRead(ctx)
+
+	if err := h.assignUsecase.Watch(ctx, ticket.ID, oneShot, func(assignment *entity.Assignment) error {
+		if assignment == nil {
+			return nil
+		}
+
+		if err := writeWebSocketMessage(ctx, c, &pb.WatchAssignmentsResponse{
+			Assignment: conv.ToPbAssignment(assignment),
+		}); err != nil {
+			return err
+		}
+
+		delivered = true
+		return nil
+	}); err != nil {
+		return toStatusError(err, "failed to watch assignments")
+	}
+
+	return nil
+}
+
+// deleteTicket deletes the ticket even after the socket has been closed.
+func (h *WebSocket) deleteTicket(ctx context.Context, ticketID string) {
+	if err := h.ticketUsecase.DeleteTicket(context.WithoutCancel(ctx), ticketID); err != nil && !errors.Is(err, entity.ErrTicketNotFound) {
+		h.logger.ErrorContext(ctx, "failed to delete ticket of closed websocket", logging.Error(err))
+	}
+}
+
+func readWebSocketMessage(ctx context.Context, c *websocket.Conn, m proto.Message) error {
+	ctx, cancel := context.WithTimeout(ctx, webSocketCreateTimeout)
+	defer cancel()
+
+	_, data, err := c.Read(ctx)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to read request: %v", err)
+	}
+
+	if err := protojson.Unmarshal(data, m); err != nil {
+		return toStatusError(entity.WithCause(entity.ErrRequestDecodeFailed, err), "invalid request")
+	}
+
+	return nil
+}
+
+func writeWebSocketMessage(ctx context.Context, c *websocket.Conn, m proto.Message) error {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return c.Write(ctx, websocket.MessageText, data)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	return strings.ToValidUTF8(s[:n], "")
+}
@@ -0,0 +1,220 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	idriver "github.com/HMasataka/collision/domain/driver"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/logging"
+	"github.com/HMasataka/collision/metrics"
+	"github.com/HMasataka/errs"
+	"github.com/redis/rueidis"
+	"github.com/rs/xid"
+)
+
+const (
+	subscribeTimeout = 1 * time.Second
+	pingInterval     = 50 * time.Millisecond
+)
+
+type assignmentEvent struct {
+	TicketIDs  []string           `json:"ticket_ids,omitempty"`
+	Assignment *entity.Assignment `json:"assignment,omitempty"`
+	// Nonce is set only on the ping published to confirm that the subscription is active.
+	Nonce string `json:"nonce,omitempty"`
+}
+
+// assignmentNotifierDriver shares a single subscription among all watchers in the process
+// and dispatches the published assignments to the watchers of each ticket.
+type assignmentNotifierDriver struct {
+	client rueidis.Client
+	logger *slog.Logger
+
+	mutex    sync.Mutex
+	running  bool
+	nonce    string
+	ready    chan struct{}
+	watchers map[string]map[chan *entity.Assignment]struct{}
+}
+
+func NewAssignmentNotifierDriver(client rueidis.Client, logger *slog.Logger) idriver.AssignmentNotifierDriver {
+	return &assignmentNotifierDriver{
+		client:   client,
+		logger:   logger,
+		watchers: map[string]map[chan *entity.Assignment]struct{}{},
+	}
+}
+
+func (d *assignmentNotifierDriver) assignmentChannel() string {
+	return "assignments"
+}
+
+// Publish reports the failure in the log and the metrics, as the callers go on without the notification.
+func (d *assignmentNotifierDriver) Publish(ctx context.Context, asg *entity.AssignmentGroup) *errs.Error {
+	if err := d.publish(ctx, &assignmentEvent{
+		TicketIDs:  asg.TicketIds,
+		Assignment: asg.Assignment,
+	}); err != nil {
+		metrics.AssignmentPublishFailures.Inc()
+		d.logger.WarnContext(ctx, "failed to publish assignment", logging.TicketIDs(asg.TicketIds), logging.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (d *assignmentNotifierDriver) publish(ctx context.Context, event *assignmentEvent) *errs.Error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return entity.WithCause(entity.ErrAssignmentEncodeFailed, err)
+	}
+
+	query := d.client.B().Publish().Channel(d.assignmentChannel()).Message(rueidis.BinaryString(data)).Build()
+	if err := d.client.Do(ctx, query).Error(); err != nil {
+		return entity.WithCause(entity.ErrAssignmentPublishFailed, err)
+	}
+
+	return nil
+}
+
+func (d *assignmentNotifierDriver) Subscribe(ctx context.Context, ticketID string) (<-chan *entity.Assignment, func(), *errs.Error) {
+	ready := d.start()
+
+	ctx, cancel := context.WithTimeout(ctx, subscribeTimeout)
+	defer cancel()
+
+	select {
+	case <-ready:
+	case <-ctx.Done():
+		return nil, nil, entity.WithCause(entity.ErrAssignmentSubscribeFailed, ctx.Err())
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	// The subscription may have been dropped while waiting.
+	if !d.running || d.ready != ready {
+		return nil, nil, entity.ErrAssignmentSubscribeFailed
+	}
+
+	ch := make(chan *entity.Assignment, 1)
+	if _, ok := d.watchers[ticketID]; !ok {
+		d.watchers[ticketID] = map[chan *entity.Assignment]struct{}{}
+	}
+	d.watchers[ticketID][ch] = struct{}{}
+
+	unsubscribe := func() {
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+
+		if _, ok := d.watchers[ticketID][ch]; !ok {
+			return
+		}
+
+		delete(d.watchers[ticketID], ch)
+		if len(d.watchers[ticketID]) == 0 {
+			delete(d.watchers, ticketID)
+		}
+	}
+
+	return ch, unsubscribe, nil
+}
+
+// start subscribes the assignment channel if it is not subscribed yet,
+// and returns a channel that is closed when the subscription is confirmed.
+func (d *assignmentNotifierDriver) start() chan struct{} {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.running {
+		return d.ready
+	}
+
+	d.running = true
+	d.nonce = xid.New().String()
+	d.ready = make(chan struct{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go d.receive(ctx)
+	go d.ping(d.nonce, d.ready, cancel)
+
+	return d.ready
+}
+
+func (d *assignmentNotifierDriver) receive(ctx context.Context) {
+	query := d.client.B().Subscribe().Channel(d.assignmentChannel()).Build()
+
+	_ = d.client.Receive(ctx, query, d.onMessage)
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.running = false
+	for ticketID, chs := range d.watchers {
+		for ch := range chs {
+			close(ch)
+		}
+		delete(d.watchers, ticketID)
+	}
+}
+
+// ping publishes the nonce until it is received, because a message published before
+// the subscription becomes active is not delivered.
+// The subscription is canceled if it cannot be confirmed in time, so that the next Subscribe starts it again.
+func (d *assignmentNotifierDriver) ping(nonce string, ready chan struct{}, cancel context.CancelFunc) {
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	timeout := time.NewTimer(subscribeTimeout)
+	defer timeout.Stop()
+
+	for {
+		_ = d.publish(context.Background(), &assignmentEvent{Nonce: nonce})
+
+		select {
+		case <-ready:
+			return
+		case <-timeout.C:
+			cancel()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *assignmentNotifierDriver) onMessage(msg rueidis.PubSubMessage) {
+	var event assignmentEvent
+	if err := json.Unmarshal([]byte(msg.Message), &event); err != nil {
+		return
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if event.Nonce != "" {
+		if event.Nonce == d.nonce {
+			select {
+			case <-d.ready:
+			default:
+				close(d.ready)
+			}
+		}
+		return
+	}
+
+	for _, ticketID := range event.TicketIDs {
+		for ch := range d.watchers[ticketID] {
+			// Watchers only need the latest assignment, so replace the one not received yet.
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- event.Assignment
+		}
+	}
+}
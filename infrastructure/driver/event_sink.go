@@ -0,0 +1,166 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/HMasataka/collision/config"
+	idriver "github.com/HMasataka/collision/domain/driver"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/infrastructure"
+	"github.com/HMasataka/collision/logging"
+	"github.com/HMasataka/collision/metrics"
+	"github.com/HMasataka/errs"
+	"github.com/redis/rueidis"
+)
+
+// EventStreamField is the field of the stream entries holding the event in JSON.
+const EventStreamField = "event"
+
+// NewEventSinkDriver returns the sink selected in the config, reporting its failures to the logger,
+// and the function to close it after the last event is emitted.
+// The redis sink has its own connection, so that the events can be streamed to Redis even with the in-memory store.
+func NewEventSinkDriver(cfg *config.Config, logger *slog.Logger) (idriver.EventSinkDriver, func(), *errs.Error) {
+	switch cfg.Events.Sink {
+	case config.EventSinkRedis:
+		client, err := infrastructure.Connect(cfg)
+		if err != nil {
+			return nil, nil, entity.WithCause(entity.ErrEventSinkSetupFailed, err)
+		}
+		return NewReportingEventSinkDriver(NewStreamEventSinkDriver(client, cfg.Events.Stream, cfg.Events.MaxLen), logger), client.Close, nil
+	case config.EventSinkFile:
+		f, err := os.OpenFile(cfg.Events.Path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, nil, entity.WithCause(entity.ErrEventSinkSetupFailed, err)
+		}
+		closeFile := func() {
+			if err := f.Close(); err != nil {
+				logger.Error("failed to close ticket event file", slog.String("path", cfg.Events.Path), logging.Error(err))
+			}
+		}
+		return NewReportingEventSinkDriver(NewWriterEventSinkDriver(f), logger), closeFile, nil
+	case config.EventSinkStdout:
+		return NewReportingEventSinkDriver(NewWriterEventSinkDriver(os.Stdout), logger), func() {}, nil
+	default:
+		return NewNopEventSinkDriver(), func() {}, nil
+	}
+}
+
+type reportingEventSinkDriver struct {
+	sink   idriver.EventSinkDriver
+	logger *slog.Logger
+}
+
+// NewReportingEventSinkDriver returns the sink that reports the failures of sink in the log and the metrics,
+// as the callers go on without the events.
+func NewReportingEventSinkDriver(sink idriver.EventSinkDriver, logger *slog.Logger) idriver.EventSinkDriver {
+	return &reportingEventSinkDriver{
+		sink:   sink,
+		logger: logger,
+	}
+}
+
+func (d *reportingEventSinkDriver) Emit(ctx context.Context, events []*entity.TicketEvent) *errs.Error {
+	if err := d.sink.Emit(ctx, events); err != nil {
+		metrics.TicketEventsDropped.Add(float64(len(events)))
+		d.logger.ErrorContext(ctx, "failed to emit ticket events",
+			slog.String("type", string(events[0].Type)),
+			slog.Int("count", len(events)),
+			logging.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+type streamEventSinkDriver struct {
+	client rueidis.Client
+	stream string
+	maxLen int64
+}
+
+// NewStreamEventSinkDriver returns the sink that appends each event to the Redis Stream.
+// The stream is trimmed to about maxLen entries, or not trimmed if maxLen is 0.
+func NewStreamEventSinkDriver(client rueidis.Client, stream string, maxLen int64) idriver.EventSinkDriver {
+	return &streamEventSinkDriver{
+		client: client,
+		stream: stream,
+		maxLen: maxLen,
+	}
+}
+
+func (d *streamEventSinkDriver) Emit(ctx context.Context, events []*entity.TicketEvent) *errs.Error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	queries := make(rueidis.Commands, 0, len(events))
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return entity.WithCause(entity.ErrEventEncodeFailed, err)
+		}
+
+		queries = append(queries, d.add(data))
+	}
+
+	for _, resp := range d.client.DoMulti(ctx, queries...) {
+		if err := resp.Error(); err != nil {
+			return entity.WithCause(entity.ErrEventEmitFailed, err)
+		}
+	}
+
+	return nil
+}
+
+func (d *streamEventSinkDriver) add(data []byte) rueidis.Completed {
+	key := d.client.B().Xadd().Key(d.stream)
+	if d.maxLen > 0 {
+		return key.Maxlen().Almost().Threshold(strconv.FormatInt(d.maxLen, 10)).Id("*").
+			FieldValue().FieldValue(EventStreamField, rueidis.BinaryString(data)).Build()
+	}
+
+	return key.Id("*").FieldValue().FieldValue(EventStreamField, rueidis.BinaryString(data)).Build()
+}
+
+type writerEventSinkDriver struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewWriterEventSinkDriver returns the sink that writes each event to w as a line of JSON.
+func NewWriterEventSinkDriver(w io.Writer) idriver.EventSinkDriver {
+	return &writerEventSinkDriver{
+		encoder: json.NewEncoder(w),
+	}
+}
+
+func (d *writerEventSinkDriver) Emit(ctx context.Context, events []*entity.TicketEvent) *errs.Error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	for _, event := range events {
+		if err := d.encoder.Encode(event); err != nil {
+			return entity.WithCause(entity.ErrEventEmitFailed, err)
+		}
+	}
+
+	return nil
+}
+
+type nopEventSinkDriver struct{}
+
+// NewNopEventSinkDriver returns the sink that discards the events.
+func NewNopEventSinkDriver() idriver.EventSinkDriver {
+	return nopEventSinkDriver{}
+}
+
+func (nopEventSinkDriver) Emit(ctx context.Context, events []*entity.TicketEvent) *errs.Error {
+	return nil
+}
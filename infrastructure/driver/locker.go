@@ -2,9 +2,12 @@ package driver
 
 import (
 	"context"
+	"time"
 
 	idriver "github.com/HMasataka/collision/domain/driver"
 	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/metrics"
+	"github.com/HMasataka/collision/tracing"
 	"github.com/HMasataka/errs"
 	"github.com/redis/rueidis/rueidislock"
 )
@@ -24,9 +27,17 @@ func NewLockerDriver(locker rueidislock.Locker) idriver.LockerDriver {
 }
 
 func (d *lockerDriver) FetchTicketLock(ctx context.Context) (context.Context, context.CancelFunc, *errs.Error) {
+	_, span := tracing.Start(ctx, "FetchTicketLock")
+	defer span.End()
+
+	defer func(start time.Time) {
+		metrics.LockWaitDuration.Observe(metrics.Since(start))
+	}(time.Now())
+
 	locked, unlock, err := d.locker.WithContext(context.Background(), d.fetchTicketsLock())
 	if err != nil {
-		return nil, nil, entity.ErrLockAcquisitionFailed.WithCause(err)
+		tracing.Fail(span, err)
+		return nil, nil, entity.WithCause(entity.ErrLockAcquisitionFailed, err)
 	}
 
 	return locked, unlock, nil
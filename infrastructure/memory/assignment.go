@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/errs"
+)
+
+type assignmentRepository struct {
+	store *Store
+}
+
+func NewAssignmentRepository(
+	store *Store,
+) repository.AssignmentRepository {
+	return &assignmentRepository{
+		store: store,
+	}
+}
+
+func (r *assignmentRepository) assignmentDataKey(ticketID string) string {
+	return fmt.Sprintf("assign:%s", ticketID)
+}
+
+func (r *assignmentRepository) Find(ctx context.Context, ticketID string) (*entity.Assignment, *errs.Error) {
+	data, ok := r.store.Get(r.assignmentDataKey(ticketID))
+	if !ok {
+		return nil, entity.ErrAssignmentNotFound
+	}
+
+	var as entity.Assignment
+	if err := json.Unmarshal(data, &as); err != nil {
+		return nil, entity.WithCause(entity.ErrAssignmentDecodeFailed, err)
+	}
+
+	return &as, nil
+}
+
+func (r *assignmentRepository) Insert(ctx context.Context, ticketIDs []string, assignment *entity.Assignment, ttl time.Duration) *errs.Error {
+	data, err := json.Marshal(assignment)
+	if err != nil {
+		return entity.WithCause(entity.ErrAssignmentEncodeFailed, err)
+	}
+
+	for _, ticketID := range ticketIDs {
+		r.store.Set(r.assignmentDataKey(ticketID), data, ttl)
+	}
+
+	return nil
+}
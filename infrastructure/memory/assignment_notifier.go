@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	idriver "github.com/HMasataka/collision/domain/driver"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/errs"
+)
+
+type assignmentNotifierDriver struct {
+	mutex    sync.Mutex
+	watchers map[string]map[chan *entity.Assignment]struct{}
+}
+
+func NewAssignmentNotifierDriver() idriver.AssignmentNotifierDriver {
+	return &assignmentNotifierDriver{
+		watchers: map[string]map[chan *entity.Assignment]struct{}{},
+	}
+}
+
+func (d *assignmentNotifierDriver) Publish(ctx context.Context, asg *entity.AssignmentGroup) *errs.Error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	for _, ticketID := range asg.TicketIds {
+		for ch := range d.watchers[ticketID] {
+			// Watchers only need the latest assignment, so replace the one not received yet.
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- asg.Assignment
+		}
+	}
+
+	return nil
+}
+
+// Subscribe never drops the subscription because it does not depend on any connection.
+func (d *assignmentNotifierDriver) Subscribe(ctx context.Context, ticketID string) (<-chan *entity.Assignment, func(), *errs.Error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	ch := make(chan *entity.Assignment, 1)
+	if _, ok := d.watchers[ticketID]; !ok {
+		d.watchers[ticketID] = map[chan *entity.Assignment]struct{}{}
+	}
+	d.watchers[ticketID][ch] = struct{}{}
+
+	unsubscribe := func() {
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+
+		delete(d.watchers[ticketID], ch)
+		if len(d.watchers[ticketID]) == 0 {
+			delete(d.watchers, ticketID)
+		}
+	}
+
+	return ch, unsubscribe, nil
+}
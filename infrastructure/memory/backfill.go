@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/errs"
+)
+
+const backfillIDKey = "backfill:ids"
+
+type backfillRepository struct {
+	store *Store
+}
+
+func NewBackfillRepository(
+	store *Store,
+) repository.BackfillRepository {
+	return &backfillRepository{
+		store: store,
+	}
+}
+
+func (r *backfillRepository) backfillDataKey(id string) string {
+	return "backfill:" + id
+}
+
+func (r *backfillRepository) Find(ctx context.Context, id string) (*entity.Backfill, *errs.Error) {
+	data, ok := r.store.Get(r.backfillDataKey(id))
+	if !ok {
+		return nil, entity.ErrBackfillNotFound
+	}
+
+	return decodeBackfill(data)
+}
+
+func (r *backfillRepository) GetBackfills(ctx context.Context) (entity.Backfills, *errs.Error) {
+	ids := r.store.SMembers(backfillIDKey)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = r.backfillDataKey(id)
+	}
+
+	m := r.store.MGet(keys)
+
+	backfills := make(entity.Backfills, 0, len(ids))
+	var expiredIDs []string
+
+	for i, key := range keys {
+		data, ok := m[key]
+		if !ok {
+			expiredIDs = append(expiredIDs, ids[i])
+			continue
+		}
+
+		backfill, err := decodeBackfill(data)
+		if err != nil {
+			return nil, err
+		}
+
+		backfills = append(backfills, backfill)
+	}
+
+	if len(expiredIDs) > 0 {
+		r.store.SRem(backfillIDKey, expiredIDs...)
+	}
+
+	return backfills, nil
+}
+
+func (r *backfillRepository) Insert(ctx context.Context, backfill *entity.Backfill, ttl time.Duration) *errs.Error {
+	data, err := json.Marshal(backfill)
+	if err != nil {
+		return entity.WithCause(entity.ErrBackfillMarshalFailed, err)
+	}
+
+	r.store.Set(r.backfillDataKey(backfill.ID), data, ttl)
+	r.store.SAdd(backfillIDKey, backfill.ID)
+
+	return nil
+}
+
+func (r *backfillRepository) Update(ctx context.Context, backfill *entity.Backfill, generation int64, ttl time.Duration) *errs.Error {
+	data, err := json.Marshal(backfill)
+	if err != nil {
+		return entity.WithCause(entity.ErrBackfillMarshalFailed, err)
+	}
+
+	var result *errs.Error
+	r.store.Modify(r.backfillDataKey(backfill.ID), ttl, func(current []byte, ok bool) ([]byte, bool) {
+		if !ok {
+			result = entity.ErrBackfillNotFound
+			return nil, false
+		}
+
+		stored, err := decodeBackfill(current)
+		if err != nil {
+			result = err
+			return nil, false
+		}
+		if stored.Generation != generation {
+			result = entity.ErrBackfillGenerationMismatch
+			return nil, false
+		}
+
+		return data, true
+	})
+
+	return result
+}
+
+func (r *backfillRepository) Delete(ctx context.Context, id string) *errs.Error {
+	r.store.Del(r.backfillDataKey(id))
+	r.store.SRem(backfillIDKey, id)
+
+	return nil
+}
+
+func decodeBackfill(data []byte) (*entity.Backfill, *errs.Error) {
+	var backfill entity.Backfill
+	if err := json.Unmarshal(data, &backfill); err != nil {
+		return nil, entity.WithCause(entity.ErrBackfillUnmarshalFailed, err)
+	}
+
+	return &backfill, nil
+}
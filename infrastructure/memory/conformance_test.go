@@ -0,0 +1,24 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/HMasataka/collision/config"
+	"github.com/HMasataka/collision/infrastructure/storetest"
+)
+
+func TestConformance(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) *storetest.Backend {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+
+		cfg := config.Default()
+		cfg.Ticket.PendingReleaseTimeout = storetest.PendingReleaseTimeout
+
+		return &storetest.Backend{
+			Repositories: NewRepository(NewStore(ctx), NewLockerDriver(), cfg),
+			Notifier:     NewAssignmentNotifierDriver(),
+		}
+	})
+}
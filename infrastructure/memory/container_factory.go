@@ -0,0 +1,25 @@
+package memory
+
+import (
+	"github.com/HMasataka/collision/config"
+	"github.com/HMasataka/collision/domain/driver"
+	"github.com/HMasataka/collision/domain/repository"
+)
+
+func NewRepository(
+	store *Store,
+	lockerDriver driver.LockerDriver,
+	cfg *config.Config,
+) *repository.RepositoryContainer {
+	ticketIDRepository := NewTicketIDRepository(store)
+	pendingTicketRepository := NewPendingTicketRepository(store, cfg.Ticket.PendingReleaseTimeout)
+
+	return &repository.RepositoryContainer{
+		TicketRepository:        NewTicketRepository(store),
+		TicketIDRepository:      ticketIDRepository,
+		PendingTicketRepository: pendingTicketRepository,
+		AssignmentRepository:    NewAssignmentRepository(store),
+		TicketIndexRepository:   NewTicketIndexRepository(lockerDriver, ticketIDRepository, pendingTicketRepository),
+		BackfillRepository:      NewBackfillRepository(store),
+	}
+}
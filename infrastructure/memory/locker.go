@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	idriver "github.com/HMasataka/collision/domain/driver"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/metrics"
+	"github.com/HMasataka/collision/tracing"
+	"github.com/HMasataka/errs"
+)
+
+type lockerDriver struct {
+	fetchTicketsLock chan struct{}
+}
+
+func NewLockerDriver() idriver.LockerDriver {
+	return &lockerDriver{
+		fetchTicketsLock: make(chan struct{}, 1),
+	}
+}
+
+// FetchTicketLock acquires the lock shared by the fetch and deindex operations.
+// The returned context is derived from ctx and canceled when the lock is released.
+// The lock is also released when ctx is canceled, so that a canceled caller does not keep the others waiting.
+func (d *lockerDriver) FetchTicketLock(ctx context.Context) (context.Context, context.CancelFunc, *errs.Error) {
+	_, span := tracing.Start(ctx, "FetchTicketLock")
+	defer span.End()
+
+	defer func(start time.Time) {
+		metrics.LockWaitDuration.Observe(metrics.Since(start))
+	}(time.Now())
+
+	select {
+	case d.fetchTicketsLock <- struct{}{}:
+	case <-ctx.Done():
+		tracing.Fail(span, ctx.Err())
+		return nil, nil, entity.WithCause(entity.ErrLockAcquisitionFailed, ctx.Err())
+	}
+
+	locked, cancel := context.WithCancel(ctx)
+
+	// The lock is released either by the caller or by the cancellation, whichever comes first.
+	var released atomic.Bool
+	unlock := func() {
+		if !released.CompareAndSwap(false, true) {
+			return
+		}
+
+		cancel()
+		<-d.fetchTicketsLock
+	}
+	context.AfterFunc(locked, unlock)
+
+	return locked, unlock, nil
+}
@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+)
+
+const testLockTimeout = time.Second
+
+func TestLockerDriverReleasedByCancel(t *testing.T) {
+	d := NewLockerDriver()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	locked, unlock, err := d.FetchTicketLock(ctx)
+	if err != nil {
+		t.Fatalf("FetchTicketLock() error = %v", err)
+	}
+	defer unlock()
+
+	cancel()
+
+	select {
+	case <-locked.Done():
+	case <-time.After(testLockTimeout):
+		t.Fatal("locked context is not canceled with the caller's context")
+	}
+
+	waitCtx, waitCancel := context.WithTimeout(context.Background(), testLockTimeout)
+	defer waitCancel()
+
+	_, unlockNext, err := d.FetchTicketLock(waitCtx)
+	if err != nil {
+		t.Fatalf("FetchTicketLock() after cancel error = %v", err)
+	}
+	unlockNext()
+}
+
+func TestLockerDriverUnlock(t *testing.T) {
+	d := NewLockerDriver()
+
+	locked, unlock, err := d.FetchTicketLock(context.Background())
+	if err != nil {
+		t.Fatalf("FetchTicketLock() error = %v", err)
+	}
+
+	// The lock is held until it is released.
+	heldCtx, heldCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer heldCancel()
+	if _, _, err := d.FetchTicketLock(heldCtx); !errors.Is(err, entity.ErrLockAcquisitionFailed) {
+		t.Fatalf("FetchTicketLock() while held error = %v, want %v", err, entity.ErrLockAcquisitionFailed)
+	}
+
+	// Releasing more than once, also concurrently, releases the lock only once.
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(unlock)
+	}
+	wg.Wait()
+
+	if locked.Err() == nil {
+		t.Error("locked context is not canceled after unlock")
+	}
+
+	for range 2 {
+		waitCtx, waitCancel := context.WithTimeout(context.Background(), testLockTimeout)
+		_, unlockNext, err := d.FetchTicketLock(waitCtx)
+		waitCancel()
+		if err != nil {
+			t.Fatalf("FetchTicketLock() after unlock error = %v", err)
+		}
+		unlockNext()
+	}
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/errs"
+)
+
+type pendingTicketRepository struct {
+	store                 *Store
+	pendingReleaseTimeout time.Duration
+}
+
+func NewPendingTicketRepository(
+	store *Store,
+	pendingReleaseTimeout time.Duration,
+) repository.PendingTicketRepository {
+	return &pendingTicketRepository{
+		store:                 store,
+		pendingReleaseTimeout: pendingReleaseTimeout,
+	}
+}
+
+func (r *pendingTicketRepository) PendingTicketKey() string {
+	return "pendingTicketIDs"
+}
+
+func (r *pendingTicketRepository) GetPendingTicketIDs(ctx context.Context) ([]string, *errs.Error) {
+	rangeMin := float64(time.Now().Add(-r.pendingReleaseTimeout).Unix())
+	rangeMax := float64(time.Now().Add(1 * time.Hour).Unix())
+
+	return r.store.ZRangeByScore(r.PendingTicketKey(), rangeMin, rangeMax), nil
+}
+
+func (r *pendingTicketRepository) CountPendingTickets(ctx context.Context) (int64, *errs.Error) {
+	rangeMin := float64(time.Now().Add(-r.pendingReleaseTimeout).Unix())
+
+	return r.store.ZCount(r.PendingTicketKey(), rangeMin, math.Inf(1)), nil
+}
+
+func (r *pendingTicketRepository) InsertPendingTicket(ctx context.Context, ticketIDs []string) *errs.Error {
+	score := float64(time.Now().Unix())
+
+	r.store.ZAdd(r.PendingTicketKey(), score, ticketIDs...)
+
+	return nil
+}
+
+func (r *pendingTicketRepository) RefreshPendingTickets(ctx context.Context, ticketIDs []string) *errs.Error {
+	score := float64(time.Now().Unix())
+
+	r.store.ZAddXX(r.PendingTicketKey(), score, ticketIDs...)
+
+	return nil
+}
+
+func (r *pendingTicketRepository) DeletePendingTickets(ctx context.Context, ticketIDs []string) *errs.Error {
+	r.store.ZRem(r.PendingTicketKey(), ticketIDs...)
+
+	return nil
+}
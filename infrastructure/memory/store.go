@@ -0,0 +1,321 @@
+package memory
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+const sweepInterval = 1 * time.Minute
+
+type value struct {
+	data     []byte
+	expireAt time.Time
+}
+
+func (v *value) expired(now time.Time) bool {
+	return !v.expireAt.IsZero() && !now.Before(v.expireAt)
+}
+
+// Store keeps the matchmaking state in memory with the same data structures as Redis:
+// values with expiration, sets and sorted sets.
+// It is intended for tests and single node deployments, and the state is lost when the process exits.
+type Store struct {
+	mutex  sync.Mutex
+	values map[string]*value
+	sets   map[string]map[string]struct{}
+	zsets  map[string]map[string]float64
+}
+
+// NewStore creates a Store and removes the expired values periodically until the context is canceled.
+func NewStore(ctx context.Context) *Store {
+	s := &Store{
+		values: map[string]*value{},
+		sets:   map[string]map[string]struct{}{},
+		zsets:  map[string]map[string]float64{},
+	}
+
+	go s.sweep(ctx)
+
+	return s
+}
+
+func (s *Store) sweep(ctx context.Context) {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.mutex.Lock()
+			for key, v := range s.values {
+				if v.expired(now) {
+					delete(s.values, key)
+				}
+			}
+			s.mutex.Unlock()
+		}
+	}
+}
+
+// get must be called with the lock held.
+func (s *Store) get(key string) (*value, bool) {
+	v, ok := s.values[key]
+	if !ok {
+		return nil, false
+	}
+
+	if v.expired(time.Now()) {
+		delete(s.values, key)
+		return nil, false
+	}
+
+	return v, true
+}
+
+func (s *Store) Get(key string) ([]byte, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	v, ok := s.get(key)
+	if !ok {
+		return nil, false
+	}
+
+	return v.data, true
+}
+
+func (s *Store) MGet(keys []string) map[string][]byte {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	m := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		if v, ok := s.get(key); ok {
+			m[key] = v.data
+		}
+	}
+
+	return m
+}
+
+func (s *Store) Exists(key string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, ok := s.get(key)
+	return ok
+}
+
+// Set stores the data. The value never expires if ttl is zero.
+func (s *Store) Set(key string, data []byte, ttl time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	v := &value{data: data}
+	if ttl > 0 {
+		v.expireAt = time.Now().Add(ttl)
+	}
+
+	s.values[key] = v
+}
+
+// Modify replaces the data with the one returned from fn atomically.
+// The value is left as it is if fn returns false.
+func (s *Store) Modify(key string, ttl time.Duration, fn func(data []byte, ok bool) ([]byte, bool)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var current []byte
+	v, ok := s.get(key)
+	if ok {
+		current = v.data
+	}
+
+	data, modify := fn(current, ok)
+	if !modify {
+		return
+	}
+
+	v = &value{data: data}
+	if ttl > 0 {
+		v.expireAt = time.Now().Add(ttl)
+	}
+
+	s.values[key] = v
+}
+
+func (s *Store) Expire(key string, ttl time.Duration) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	v, ok := s.get(key)
+	if !ok {
+		return false
+	}
+
+	v.expireAt = time.Now().Add(ttl)
+	return true
+}
+
+func (s *Store) Del(keys ...string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, key := range keys {
+		delete(s.values, key)
+	}
+}
+
+func (s *Store) SAdd(key string, members ...string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	set, ok := s.sets[key]
+	if !ok {
+		set = map[string]struct{}{}
+		s.sets[key] = set
+	}
+
+	for _, member := range members {
+		set[member] = struct{}{}
+	}
+}
+
+func (s *Store) SRem(key string, members ...string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	set, ok := s.sets[key]
+	if !ok {
+		return
+	}
+
+	for _, member := range members {
+		delete(set, member)
+	}
+
+	if len(set) == 0 {
+		delete(s.sets, key)
+	}
+}
+
+func (s *Store) SMembers(key string) []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	members := make([]string, 0, len(s.sets[key]))
+	for member := range s.sets[key] {
+		members = append(members, member)
+	}
+
+	return members
+}
+
+// SRandMember returns up to count distinct random members like SRANDMEMBER with a positive count.
+func (s *Store) SRandMember(key string, count int64) []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	set := s.sets[key]
+	members := make([]string, 0, len(set))
+	for member := range set {
+		members = append(members, member)
+	}
+
+	rand.Shuffle(len(members), func(i, j int) {
+		members[i], members[j] = members[j], members[i]
+	})
+
+	if int64(len(members)) > count {
+		members = members[:count]
+	}
+
+	return members
+}
+
+func (s *Store) SCard(key string) int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return int64(len(s.sets[key]))
+}
+
+func (s *Store) ZAdd(key string, score float64, members ...string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	zset, ok := s.zsets[key]
+	if !ok {
+		zset = map[string]float64{}
+		s.zsets[key] = zset
+	}
+
+	for _, member := range members {
+		zset[member] = score
+	}
+}
+
+// ZAddXX updates the scores of the members that already exist, like ZADD XX.
+func (s *Store) ZAddXX(key string, score float64, members ...string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	zset := s.zsets[key]
+	for _, member := range members {
+		if _, ok := zset[member]; ok {
+			zset[member] = score
+		}
+	}
+}
+
+func (s *Store) ZRem(key string, members ...string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	zset, ok := s.zsets[key]
+	if !ok {
+		return
+	}
+
+	for _, member := range members {
+		delete(zset, member)
+	}
+
+	if len(zset) == 0 {
+		delete(s.zsets, key)
+	}
+}
+
+// ZCount returns the number of the members whose score is between min and max inclusive.
+func (s *Store) ZCount(key string, min, max float64) int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var count int64
+	for _, score := range s.zsets[key] {
+		if min <= score && score <= max {
+			count++
+		}
+	}
+
+	return count
+}
+
+// ZRangeByScore returns the members whose score is between min and max inclusive.
+func (s *Store) ZRangeByScore(key string, min, max float64) []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var members []string
+	for member, score := range s.zsets[key] {
+		if min <= score && score <= max {
+			members = append(members, member)
+		}
+	}
+
+	return members
+}
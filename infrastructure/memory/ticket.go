@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/errs"
+)
+
+type ticketRepository struct {
+	store *Store
+}
+
+func NewTicketRepository(
+	store *Store,
+) repository.TicketRepository {
+	return &ticketRepository{
+		store: store,
+	}
+}
+
+func (r *ticketRepository) TicketDataKey(ticketID string) string {
+	return ticketID
+}
+
+func (r *ticketRepository) GetTickets(ctx context.Context, ticketIDs []string) (entity.Tickets, []string, *errs.Error) {
+	keys := make([]string, len(ticketIDs))
+	for i, ticketID := range ticketIDs {
+		keys[i] = r.TicketDataKey(ticketID)
+	}
+
+	m := r.store.MGet(keys)
+
+	tickets := make(entity.Tickets, 0, len(keys))
+	var ticketIDsNotFound []string
+
+	for i, key := range keys {
+		data, ok := m[key]
+		if !ok {
+			ticketIDsNotFound = append(ticketIDsNotFound, ticketIDs[i])
+			continue
+		}
+
+		var ticket entity.Ticket
+
+		if err := json.Unmarshal(data, &ticket); err != nil {
+			return nil, nil, entity.WithCause(entity.ErrTicketUnmarshalFailed, err)
+		}
+
+		tickets = append(tickets, &ticket)
+	}
+
+	return tickets, ticketIDsNotFound, nil
+}
+
+func (r *ticketRepository) Find(ctx context.Context, id string) (*entity.Ticket, *errs.Error) {
+	data, ok := r.store.Get(r.TicketDataKey(id))
+	if !ok {
+		return nil, entity.ErrTicketNotFound
+	}
+
+	var ticket entity.Ticket
+	if err := json.Unmarshal(data, &ticket); err != nil {
+		return nil, entity.WithCause(entity.ErrTicketUnmarshalFailed, err)
+	}
+
+	return &ticket, nil
+}
+
+func (r *ticketRepository) Exists(ctx context.Context, id string) (bool, *errs.Error) {
+	return r.store.Exists(r.TicketDataKey(id)), nil
+}
+
+func (r *ticketRepository) Insert(ctx context.Context, target *entity.Ticket, ttl time.Duration) *errs.Error {
+	data, err := json.Marshal(target)
+	if err != nil {
+		return entity.WithCause(entity.ErrTicketMarshalFailed, err)
+	}
+
+	r.store.Set(r.TicketDataKey(target.ID), data, ttl)
+
+	return nil
+}
+
+func (r *ticketRepository) Expire(ctx context.Context, ticketIDs []string, ttl time.Duration) *errs.Error {
+	for _, ticketID := range ticketIDs {
+		r.store.Expire(r.TicketDataKey(ticketID), ttl)
+	}
+
+	return nil
+}
+
+func (r *ticketRepository) Delete(ctx context.Context, target *entity.Ticket) *errs.Error {
+	r.store.Del(r.TicketDataKey(target.ID))
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/errs"
+)
+
+type ticketIDRepository struct {
+	store *Store
+}
+
+func NewTicketIDRepository(
+	store *Store,
+) repository.TicketIDRepository {
+	return &ticketIDRepository{
+		store: store,
+	}
+}
+
+func (r *ticketIDRepository) TicketIDKey() string {
+	return "ticket:ids"
+}
+
+func (r *ticketIDRepository) GetAllTicketIDs(ctx context.Context, limit int64) ([]string, *errs.Error) {
+	return r.store.SRandMember(r.TicketIDKey(), limit), nil
+}
+
+func (r *ticketIDRepository) CountTicketIDs(ctx context.Context) (int64, *errs.Error) {
+	return r.store.SCard(r.TicketIDKey()), nil
+}
+
+func (r *ticketIDRepository) Insert(ctx context.Context, ticketID string) *errs.Error {
+	r.store.SAdd(r.TicketIDKey(), ticketID)
+
+	return nil
+}
+
+func (r *ticketIDRepository) Delete(ctx context.Context, ticketIDs []string) *errs.Error {
+	r.store.SRem(r.TicketIDKey(), ticketIDs...)
+
+	return nil
+}
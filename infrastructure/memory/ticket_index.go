@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/HMasataka/collision/domain/driver"
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/errs"
+	"github.com/samber/lo"
+)
+
+// ticketIndexRepository serializes the fetch and the deindex with the in-process lock.
+type ticketIndexRepository struct {
+	lockerDriver            driver.LockerDriver
+	ticketIDRepository      repository.TicketIDRepository
+	pendingTicketRepository repository.PendingTicketRepository
+}
+
+func NewTicketIndexRepository(
+	lockerDriver driver.LockerDriver,
+	ticketIDRepository repository.TicketIDRepository,
+	pendingTicketRepository repository.PendingTicketRepository,
+) repository.TicketIndexRepository {
+	return &ticketIndexRepository{
+		lockerDriver:            lockerDriver,
+		ticketIDRepository:      ticketIDRepository,
+		pendingTicketRepository: pendingTicketRepository,
+	}
+}
+
+func (r *ticketIndexRepository) FetchActiveTicketIDs(ctx context.Context, limit int64) ([]string, *errs.Error) {
+	lockedCtx, unlock, err := r.lockerDriver.FetchTicketLock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	allTicketIDs, err := r.ticketIDRepository.GetAllTicketIDs(lockedCtx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingTicketIDs, err := r.pendingTicketRepository.GetPendingTicketIDs(lockedCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	activeTicketIDs, _ := lo.Difference(allTicketIDs, pendingTicketIDs)
+	if len(activeTicketIDs) == 0 {
+		return nil, nil
+	}
+
+	if err := r.pendingTicketRepository.InsertPendingTicket(lockedCtx, activeTicketIDs); err != nil {
+		return nil, err
+	}
+
+	return activeTicketIDs, nil
+}
+
+func (r *ticketIndexRepository) DeindexTickets(ctx context.Context, ticketIDs []string) *errs.Error {
+	lockedCtx, unlock, err := r.lockerDriver.FetchTicketLock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := r.pendingTicketRepository.DeletePendingTickets(lockedCtx, ticketIDs); err != nil {
+		return err
+	}
+
+	return r.ticketIDRepository.Delete(lockedCtx, ticketIDs)
+}
+
+func (r *ticketIndexRepository) ReleaseTickets(ctx context.Context, ticketIDs []string) *errs.Error {
+	lockedCtx, unlock, err := r.lockerDriver.FetchTicketLock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return r.pendingTicketRepository.DeletePendingTickets(lockedCtx, ticketIDs)
+}
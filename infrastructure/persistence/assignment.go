@@ -0,0 +1,76 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/errs"
+	"github.com/redis/rueidis"
+)
+
+type assignmentRepository struct {
+	client rueidis.Client
+}
+
+func NewAssignmentRepository(
+	client rueidis.Client,
+) repository.AssignmentRepository {
+	return &assignmentRepository{
+		client: client,
+	}
+}
+
+func (r *assignmentRepository) assignmentDataKey(ticketID string) string {
+	return fmt.Sprintf("assign:%s", ticketID)
+}
+
+func (r *assignmentRepository) Find(ctx context.Context, ticketID string) (*entity.Assignment, *errs.Error) {
+	query := r.client.B().Get().Key(r.assignmentDataKey(ticketID)).Build()
+
+	resp := r.client.Do(ctx, query)
+	if err := resp.Error(); err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil, entity.ErrAssignmentNotFound
+		}
+		return nil, entity.WithCause(entity.ErrAssignmentGetFailed, err)
+	}
+
+	data, err := resp.AsBytes()
+	if err != nil {
+		return nil, entity.WithCause(entity.ErrAssignmentGetFailed, err)
+	}
+
+	var as entity.Assignment
+	if err := json.Unmarshal(data, &as); err != nil {
+		return nil, entity.WithCause(entity.ErrAssignmentDecodeFailed, err)
+	}
+
+	return &as, nil
+}
+
+func (r *assignmentRepository) Insert(ctx context.Context, ticketIDs []string, assignment *entity.Assignment, ttl time.Duration) *errs.Error {
+	data, err := json.Marshal(assignment)
+	if err != nil {
+		return entity.WithCause(entity.ErrAssignmentEncodeFailed, err)
+	}
+
+	queries := make([]rueidis.Completed, len(ticketIDs))
+	for i, ticketID := range ticketIDs {
+		queries[i] = r.client.B().Set().
+			Key(r.assignmentDataKey(ticketID)).
+			Value(rueidis.BinaryString(data)).
+			Ex(ttl).Build()
+	}
+
+	for _, resp := range r.client.DoMulti(ctx, queries...) {
+		if err := resp.Error(); err != nil {
+			return entity.WithCause(entity.ErrAssignmentSetFailed, err)
+		}
+	}
+
+	return nil
+}
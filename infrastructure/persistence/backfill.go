@@ -0,0 +1,192 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/errs"
+	"github.com/redis/rueidis"
+)
+
+const (
+	backfillIDKey = "backfill:ids"
+
+	backfillGenerationField = "generation"
+	backfillDataField       = "data"
+)
+
+// KEYS[1]: backfill
+// ARGV[1]: expected generation, ARGV[2]: new generation, ARGV[3]: data, ARGV[4]: ttl in milliseconds
+// Returns -1 if the backfill is not found, 0 if the generation does not match and 1 if it is updated.
+var updateBackfillScript = rueidis.NewLuaScript(`
+local generation = redis.call('HGET', KEYS[1], 'generation')
+if not generation then
+	return -1
+end
+if generation ~= ARGV[1] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'generation', ARGV[2], 'data', ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ARGV[4])
+return 1
+`)
+
+type backfillRepository struct {
+	client rueidis.Client
+}
+
+// NewBackfillRepository returns the repository that stores each backfill in a hash with its generation,
+// so that the generation can be compared and updated atomically by a script.
+func NewBackfillRepository(
+	client rueidis.Client,
+) repository.BackfillRepository {
+	return &backfillRepository{
+		client: client,
+	}
+}
+
+func (r *backfillRepository) backfillDataKey(id string) string {
+	return fmt.Sprintf("backfill:%s", id)
+}
+
+func (r *backfillRepository) Find(ctx context.Context, id string) (*entity.Backfill, *errs.Error) {
+	query := r.client.B().Hget().Key(r.backfillDataKey(id)).Field(backfillDataField).Build()
+
+	data, err := r.client.Do(ctx, query).AsBytes()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil, entity.ErrBackfillNotFound
+		}
+		return nil, entity.WithCause(entity.ErrBackfillGetFailed, err)
+	}
+
+	return decodeBackfill(data)
+}
+
+func (r *backfillRepository) GetBackfills(ctx context.Context) (entity.Backfills, *errs.Error) {
+	ids, err := r.client.Do(ctx, r.client.B().Smembers().Key(backfillIDKey).Build()).AsStrSlice()
+	if err != nil {
+		return nil, entity.WithCause(entity.ErrBackfillGetFailed, err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	queries := make([]rueidis.Completed, len(ids))
+	for i, id := range ids {
+		queries[i] = r.client.B().Hget().Key(r.backfillDataKey(id)).Field(backfillDataField).Build()
+	}
+
+	backfills := make(entity.Backfills, 0, len(ids))
+	var expiredIDs []string
+
+	for i, resp := range r.client.DoMulti(ctx, queries...) {
+		data, err := resp.AsBytes()
+		if err != nil {
+			if rueidis.IsRedisNil(err) {
+				expiredIDs = append(expiredIDs, ids[i])
+				continue
+			}
+			return nil, entity.WithCause(entity.ErrBackfillGetFailed, err)
+		}
+
+		backfill, derr := decodeBackfill(data)
+		if derr != nil {
+			return nil, derr
+		}
+
+		backfills = append(backfills, backfill)
+	}
+
+	if len(expiredIDs) > 0 {
+		query := r.client.B().Srem().Key(backfillIDKey).Member(expiredIDs...).Build()
+		if err := r.client.Do(ctx, query).Error(); err != nil {
+			return nil, entity.WithCause(entity.ErrIndexDeleteFailed, err)
+		}
+	}
+
+	return backfills, nil
+}
+
+func (r *backfillRepository) Insert(ctx context.Context, backfill *entity.Backfill, ttl time.Duration) *errs.Error {
+	data, err := json.Marshal(backfill)
+	if err != nil {
+		return entity.WithCause(entity.ErrBackfillMarshalFailed, err)
+	}
+
+	key := r.backfillDataKey(backfill.ID)
+	queries := []rueidis.Completed{
+		r.client.B().Hset().Key(key).FieldValue().
+			FieldValue(backfillGenerationField, strconv.FormatInt(backfill.Generation, 10)).
+			FieldValue(backfillDataField, rueidis.BinaryString(data)).
+			Build(),
+		r.client.B().Pexpire().Key(key).Milliseconds(ttl.Milliseconds()).Build(),
+		r.client.B().Sadd().Key(backfillIDKey).Member(backfill.ID).Build(),
+	}
+
+	for _, resp := range r.client.DoMulti(ctx, queries...) {
+		if err := resp.Error(); err != nil {
+			return entity.WithCause(entity.ErrBackfillSetFailed, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *backfillRepository) Update(ctx context.Context, backfill *entity.Backfill, generation int64, ttl time.Duration) *errs.Error {
+	data, err := json.Marshal(backfill)
+	if err != nil {
+		return entity.WithCause(entity.ErrBackfillMarshalFailed, err)
+	}
+
+	keys := []string{r.backfillDataKey(backfill.ID)}
+	args := []string{
+		strconv.FormatInt(generation, 10),
+		strconv.FormatInt(backfill.Generation, 10),
+		rueidis.BinaryString(data),
+		strconv.FormatInt(ttl.Milliseconds(), 10),
+	}
+
+	result, err := updateBackfillScript.Exec(ctx, r.client, keys, args).AsInt64()
+	if err != nil {
+		return entity.WithCause(entity.ErrBackfillSetFailed, err)
+	}
+
+	switch result {
+	case -1:
+		return entity.ErrBackfillNotFound
+	case 0:
+		return entity.ErrBackfillGenerationMismatch
+	}
+
+	return nil
+}
+
+func (r *backfillRepository) Delete(ctx context.Context, id string) *errs.Error {
+	queries := []rueidis.Completed{
+		r.client.B().Del().Key(r.backfillDataKey(id)).Build(),
+		r.client.B().Srem().Key(backfillIDKey).Member(id).Build(),
+	}
+
+	for _, resp := range r.client.DoMulti(ctx, queries...) {
+		if err := resp.Error(); err != nil {
+			return entity.WithCause(entity.ErrBackfillDeleteFailed, err)
+		}
+	}
+
+	return nil
+}
+
+func decodeBackfill(data []byte) (*entity.Backfill, *errs.Error) {
+	var backfill entity.Backfill
+	if err := json.Unmarshal(data, &backfill); err != nil {
+		return nil, entity.WithCause(entity.ErrBackfillUnmarshalFailed, err)
+	}
+
+	return &backfill, nil
+}
@@ -0,0 +1,98 @@
+package persistence
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/HMasataka/collision/config"
+	"github.com/HMasataka/collision/infrastructure/driver"
+	"github.com/HMasataka/collision/infrastructure/storetest"
+	"github.com/redis/rueidis"
+	"github.com/redis/rueidis/rueidislock"
+)
+
+// testRedisDB is the database flushed by the tests, so that they do not clear the data of the server.
+const testRedisDB = 15
+
+// testRedisAddress returns the address of the Redis the tests run against.
+// It can be set with COLLISION_TEST_REDIS_ADDRESS, and the tests are skipped if Redis is unavailable.
+func testRedisAddress() string {
+	if address := os.Getenv("COLLISION_TEST_REDIS_ADDRESS"); address != "" {
+		return address
+	}
+	return "127.0.0.1:6379"
+}
+
+func newTestClient(t testing.TB) rueidis.Client {
+	t.Helper()
+
+	option := rueidis.ClientOption{
+		InitAddress:  []string{testRedisAddress()},
+		SelectDB:     testRedisDB,
+		DisableCache: true,
+	}
+
+	client, err := rueidis.NewClient(option)
+	if err != nil {
+		t.Skipf("Redis is unavailable at %s: %v", testRedisAddress(), err)
+	}
+	t.Cleanup(client.Close)
+
+	if err := client.Do(context.Background(), client.B().Flushdb().Build()).Error(); err != nil {
+		t.Fatalf("FLUSHDB error = %v", err)
+	}
+
+	return client
+}
+
+func newTestLocker(t testing.TB) rueidislock.Locker {
+	t.Helper()
+
+	locker, err := rueidislock.NewLocker(rueidislock.LockerOption{
+		ClientOption: rueidis.ClientOption{
+			InitAddress:  []string{testRedisAddress()},
+			SelectDB:     testRedisDB,
+			DisableCache: true,
+		},
+		KeyMajority:    1,
+		NoLoopTracking: true,
+	})
+	if err != nil {
+		t.Fatalf("rueidislock.NewLocker() error = %v", err)
+	}
+	t.Cleanup(locker.Close)
+
+	return locker
+}
+
+func TestConformance(t *testing.T) {
+	tests := []struct {
+		name        string
+		ticketIndex config.TicketIndexMode
+	}{
+		{name: "script", ticketIndex: config.TicketIndexScript},
+		{name: "lock", ticketIndex: config.TicketIndexLock},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storetest.Run(t, func(t *testing.T) *storetest.Backend {
+				client := newTestClient(t)
+
+				cfg := config.Default()
+				cfg.Redis.TicketIndex = tt.ticketIndex
+				cfg.Ticket.PendingReleaseTimeout = storetest.PendingReleaseTimeout
+
+				logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+				return &storetest.Backend{
+					Repositories: newRepository(client, driver.NewLockerDriver(newTestLocker(t)), cfg),
+					Notifier:     driver.NewAssignmentNotifierDriver(client, logger),
+				}
+			})
+		})
+	}
+}
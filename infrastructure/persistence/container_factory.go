@@ -3,6 +3,7 @@ package persistence
 import (
 	"sync"
 
+	"github.com/HMasataka/collision/config"
 	"github.com/HMasataka/collision/domain/driver"
 	"github.com/HMasataka/collision/domain/repository"
 	"github.com/redis/rueidis"
@@ -16,9 +17,10 @@ var (
 func NewRepositoryOnce(
 	client rueidis.Client,
 	lockerDriver driver.LockerDriver,
+	cfg *config.Config,
 ) *repository.RepositoryContainer {
 	containerOnce.Do(func() {
-		container = newRepository(client, lockerDriver)
+		container = newRepository(client, lockerDriver, cfg)
 	})
 
 	return container
@@ -27,10 +29,25 @@ func NewRepositoryOnce(
 func newRepository(
 	client rueidis.Client,
 	lockerDriver driver.LockerDriver,
+	cfg *config.Config,
 ) *repository.RepositoryContainer {
+	ticketIDRepository := NewTicketIDRepository(client)
+	pendingTicketRepository := NewPendingTicketRepository(client, cfg.Ticket.PendingReleaseTimeout)
+
+	var ticketIndexRepository repository.TicketIndexRepository
+	switch cfg.Redis.TicketIndex {
+	case config.TicketIndexLock:
+		ticketIndexRepository = NewLockedTicketIndexRepository(lockerDriver, ticketIDRepository, pendingTicketRepository)
+	default:
+		ticketIndexRepository = NewTicketIndexRepository(client, cfg.Ticket.PendingReleaseTimeout)
+	}
+
 	return &repository.RepositoryContainer{
 		TicketRepository:        NewTicketRepository(client),
-		TicketIDRepository:      NewTicketIDRepository(client),
-		PendingTicketRepository: NewPendingTicketRepository(client, lockerDriver),
+		TicketIDRepository:      ticketIDRepository,
+		PendingTicketRepository: pendingTicketRepository,
+		AssignmentRepository:    NewAssignmentRepository(client),
+		TicketIndexRepository:   ticketIndexRepository,
+		BackfillRepository:      NewBackfillRepository(client),
 	}
 }
@@ -5,34 +5,35 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/HMasataka/collision/domain/driver"
 	"github.com/HMasataka/collision/domain/entity"
 	"github.com/HMasataka/collision/domain/repository"
 	"github.com/HMasataka/errs"
 	"github.com/redis/rueidis"
 )
 
+const pendingTicketKey = "{ticket}:pendingTicketIDs"
+
 type pendingTicketRepository struct {
-	client       rueidis.Client
-	lockerDriver driver.LockerDriver
+	client                rueidis.Client
+	pendingReleaseTimeout time.Duration
 }
 
 func NewPendingTicketRepository(
 	client rueidis.Client,
-	lockerDriver driver.LockerDriver,
+	pendingReleaseTimeout time.Duration,
 ) repository.PendingTicketRepository {
 	return &pendingTicketRepository{
-		client:       client,
-		lockerDriver: lockerDriver,
+		client:                client,
+		pendingReleaseTimeout: pendingReleaseTimeout,
 	}
 }
 
 func (r *pendingTicketRepository) PendingTicketKey() string {
-	return "pendingTicketIDs"
+	return pendingTicketKey
 }
 
 func (r *pendingTicketRepository) GetPendingTicketIDs(ctx context.Context) ([]string, *errs.Error) {
-	rangeMin := strconv.FormatInt(time.Now().Add(-defaultPendingReleaseTimeout).Unix(), 10)
+	rangeMin := strconv.FormatInt(time.Now().Add(-r.pendingReleaseTimeout).Unix(), 10)
 	rangeMax := strconv.FormatInt(time.Now().Add(1*time.Hour).Unix(), 10)
 
 	query := r.client.B().Zrangebyscore().Key(r.PendingTicketKey()).Min(rangeMin).Max(rangeMax).Build()
@@ -42,17 +43,30 @@ func (r *pendingTicketRepository) GetPendingTicketIDs(ctx context.Context) ([]st
 		if rueidis.IsRedisNil(err) {
 			return nil, nil
 		}
-		return nil, entity.ErrPendingTicketGetFailed.WithCause(err)
+		return nil, entity.WithCause(entity.ErrPendingTicketGetFailed, err)
 	}
 
 	pendingTicketIDs, err := resp.AsStrSlice()
 	if err != nil {
-		return nil, entity.ErrIndexDecodeFailed.WithCause(err)
+		return nil, entity.WithCause(entity.ErrIndexDecodeFailed, err)
 	}
 
 	return pendingTicketIDs, nil
 }
 
+func (r *pendingTicketRepository) CountPendingTickets(ctx context.Context) (int64, *errs.Error) {
+	rangeMin := strconv.FormatInt(time.Now().Add(-r.pendingReleaseTimeout).Unix(), 10)
+
+	query := r.client.B().Zcount().Key(r.PendingTicketKey()).Min(rangeMin).Max("+inf").Build()
+
+	count, err := r.client.Do(ctx, query).AsInt64()
+	if err != nil {
+		return 0, entity.WithCause(entity.ErrPendingTicketGetFailed, err)
+	}
+
+	return count, nil
+}
+
 func (r *pendingTicketRepository) InsertPendingTicket(ctx context.Context, ticketIDs []string) *errs.Error {
 	score := float64(time.Now().Unix())
 
@@ -63,24 +77,34 @@ func (r *pendingTicketRepository) InsertPendingTicket(ctx context.Context, ticke
 
 	resp := r.client.Do(ctx, query.Build())
 	if err := resp.Error(); err != nil {
-		return entity.ErrPendingTicketSetFailed.WithCause(err)
+		return entity.WithCause(entity.ErrPendingTicketSetFailed, err)
 	}
 
 	return nil
 }
 
-func (r *pendingTicketRepository) ReleaseTickets(ctx context.Context, ticketIDs []string) *errs.Error {
-	lockedCtx, unlock, err := r.lockerDriver.FetchTicketLock(ctx)
-	if err != nil {
-		return entity.ErrLockAcquisitionFailed.WithCause(err)
+func (r *pendingTicketRepository) RefreshPendingTickets(ctx context.Context, ticketIDs []string) *errs.Error {
+	score := float64(time.Now().Unix())
+
+	query := r.client.B().Zadd().Key(r.PendingTicketKey()).Xx().ScoreMember()
+	for _, ticketID := range ticketIDs {
+		query = query.ScoreMember(score, ticketID)
+	}
+
+	resp := r.client.Do(ctx, query.Build())
+	if err := resp.Error(); err != nil {
+		return entity.WithCause(entity.ErrPendingTicketSetFailed, err)
 	}
-	defer unlock()
 
+	return nil
+}
+
+func (r *pendingTicketRepository) DeletePendingTickets(ctx context.Context, ticketIDs []string) *errs.Error {
 	query := r.client.B().Zrem().Key(r.PendingTicketKey()).Member(ticketIDs...).Build()
 
-	resp := r.client.Do(lockedCtx, query)
+	resp := r.client.Do(ctx, query)
 	if err := resp.Error(); err != nil {
-		return entity.ErrPendingTicketReleaseFailed.WithCause(err)
+		return entity.WithCause(entity.ErrPendingTicketReleaseFailed, err)
 	}
 
 	return nil
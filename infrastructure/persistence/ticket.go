@@ -11,10 +11,6 @@ import (
 	"github.com/redis/rueidis"
 )
 
-const (
-	defaultPendingReleaseTimeout = 1 * time.Minute
-)
-
 type ticketRepository struct {
 	client rueidis.Client
 }
@@ -43,7 +39,7 @@ func (r *ticketRepository) GetTickets(ctx context.Context, ticketIDs []string) (
 
 	m, err := rueidis.MGet(r.client, ctx, keys)
 	if err != nil {
-		return nil, nil, entity.ErrTicketGetFailed.WithCause(err)
+		return nil, nil, entity.WithCause(entity.ErrTicketGetFailed, err)
 	}
 
 	tickets := make(entity.Tickets, 0, len(keys))
@@ -55,18 +51,18 @@ func (r *ticketRepository) GetTickets(ctx context.Context, ticketIDs []string) (
 				ticketIDsNotFound = append(ticketIDsNotFound, r.ticketIDFromRedisKey(key))
 				continue
 			}
-			return nil, nil, entity.ErrTicketGetFailed.WithCause(err)
+			return nil, nil, entity.WithCause(entity.ErrTicketGetFailed, err)
 		}
 
 		data, err := resp.AsBytes()
 		if err != nil {
-			return nil, nil, entity.ErrTicketGetFailed.WithCause(err)
+			return nil, nil, entity.WithCause(entity.ErrTicketGetFailed, err)
 		}
 
 		var ticket entity.Ticket
 
 		if err := json.Unmarshal(data, &ticket); err != nil {
-			return nil, nil, entity.ErrTicketUnmarshalFailed.WithCause(err)
+			return nil, nil, entity.WithCause(entity.ErrTicketUnmarshalFailed, err)
 		}
 
 		tickets = append(tickets, &ticket)
@@ -79,21 +75,69 @@ func (r *ticketRepository) Find(ctx context.Context, id string) (*entity.Ticket,
 	query := r.client.B().Get().Key(r.TicketDataKey(id)).Build()
 	data, err := r.client.Do(ctx, query).AsBytes()
 	if err != nil {
-		return nil, entity.ErrTicketGetFailed.WithCause(err)
+		if rueidis.IsRedisNil(err) {
+			return nil, entity.ErrTicketNotFound
+		}
+		return nil, entity.WithCause(entity.ErrTicketGetFailed, err)
 	}
 
 	var ticket entity.Ticket
 	if err := json.Unmarshal(data, &ticket); err != nil {
-		return nil, entity.ErrTicketUnmarshalFailed.WithCause(err)
+		return nil, entity.WithCause(entity.ErrTicketUnmarshalFailed, err)
 	}
 
 	return &ticket, nil
 }
 
+func (r *ticketRepository) Exists(ctx context.Context, id string) (bool, *errs.Error) {
+	query := r.client.B().Exists().Key(r.TicketDataKey(id)).Build()
+	n, err := r.client.Do(ctx, query).AsInt64()
+	if err != nil {
+		return false, entity.WithCause(entity.ErrTicketGetFailed, err)
+	}
+
+	return n > 0, nil
+}
+
+func (r *ticketRepository) Insert(ctx context.Context, target *entity.Ticket, ttl time.Duration) *errs.Error {
+	data, err := json.Marshal(target)
+	if err != nil {
+		return entity.WithCause(entity.ErrTicketMarshalFailed, err)
+	}
+
+	query := r.client.B().Set().
+		Key(r.TicketDataKey(target.ID)).
+		Value(rueidis.BinaryString(data)).
+		Ex(ttl).
+		Build()
+
+	if err := r.client.Do(ctx, query).Error(); err != nil {
+		return entity.WithCause(entity.ErrTicketCreateFailed, err)
+	}
+
+	return nil
+}
+
+func (r *ticketRepository) Expire(ctx context.Context, ticketIDs []string, ttl time.Duration) *errs.Error {
+	queries := make([]rueidis.Completed, len(ticketIDs))
+
+	for i, ticketID := range ticketIDs {
+		queries[i] = r.client.B().Expire().Key(r.TicketDataKey(ticketID)).Seconds(int64(ttl.Seconds())).Build()
+	}
+
+	for _, resp := range r.client.DoMulti(ctx, queries...) {
+		if err := resp.Error(); err != nil {
+			return entity.WithCause(entity.ErrTicketExpirationFailed, err)
+		}
+	}
+
+	return nil
+}
+
 func (r *ticketRepository) Delete(ctx context.Context, target *entity.Ticket) *errs.Error {
 	query := r.client.B().Del().Key(r.TicketDataKey(target.ID)).Build()
 	if err := r.client.Do(ctx, query).Error(); err != nil {
-		return entity.ErrTicketDeleteFailed.WithCause(err)
+		return entity.WithCause(entity.ErrTicketDeleteFailed, err)
 	}
 
 	return nil
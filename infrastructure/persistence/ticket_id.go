@@ -9,6 +9,10 @@ import (
 	"github.com/redis/rueidis"
 )
 
+// The ticket index and the pending tickets share the hash tag,
+// so that the ticket index scripts can access both of them on Redis Cluster.
+const ticketIDKey = "{ticket}:ids"
+
 type ticketIDRepository struct {
 	client rueidis.Client
 }
@@ -22,7 +26,7 @@ func NewTicketIDRepository(
 }
 
 func (r *ticketIDRepository) TicketIDKey() string {
-	return "ticket:ids"
+	return ticketIDKey
 }
 
 func (r *ticketIDRepository) GetAllTicketIDs(ctx context.Context, limit int64) ([]string, *errs.Error) {
@@ -34,13 +38,42 @@ func (r *ticketIDRepository) GetAllTicketIDs(ctx context.Context, limit int64) (
 			return nil, nil
 		}
 
-		return nil, entity.ErrIndexGetFailed.WithCause(err)
+		return nil, entity.WithCause(entity.ErrIndexGetFailed, err)
 	}
 
 	allTicketIDs, err := resp.AsStrSlice()
 	if err != nil {
-		return nil, entity.ErrIndexDecodeFailed.WithCause(err)
+		return nil, entity.WithCause(entity.ErrIndexDecodeFailed, err)
 	}
 
 	return allTicketIDs, nil
 }
+
+func (r *ticketIDRepository) CountTicketIDs(ctx context.Context) (int64, *errs.Error) {
+	query := r.client.B().Scard().Key(r.TicketIDKey()).Build()
+
+	count, err := r.client.Do(ctx, query).AsInt64()
+	if err != nil {
+		return 0, entity.WithCause(entity.ErrIndexGetFailed, err)
+	}
+
+	return count, nil
+}
+
+func (r *ticketIDRepository) Insert(ctx context.Context, ticketID string) *errs.Error {
+	query := r.client.B().Sadd().Key(r.TicketIDKey()).Member(ticketID).Build()
+	if err := r.client.Do(ctx, query).Error(); err != nil {
+		return entity.WithCause(entity.ErrTicketCreateFailed, err)
+	}
+
+	return nil
+}
+
+func (r *ticketIDRepository) Delete(ctx context.Context, ticketIDs []string) *errs.Error {
+	query := r.client.B().Srem().Key(r.TicketIDKey()).Member(ticketIDs...).Build()
+	if err := r.client.Do(ctx, query).Error(); err != nil {
+		return entity.WithCause(entity.ErrIndexDeleteFailed, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package persistence
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/errs"
+	"github.com/redis/rueidis"
+)
+
+// Redis limits the number of arguments that can be unpacked at once, so the scripts process the tickets in chunks.
+var (
+	// KEYS[1]: ticket index, KEYS[2]: pending tickets
+	// ARGV[1]: limit, ARGV[2]: score of the pending tickets, ARGV[3]: score before which the pending tickets are released
+	fetchActiveTicketIDsScript = rueidis.NewLuaScript(`
+local ticketIDs = redis.call('SRANDMEMBER', KEYS[1], ARGV[1])
+local activeTicketIDs = {}
+for _, ticketID in ipairs(ticketIDs) do
+	local score = redis.call('ZSCORE', KEYS[2], ticketID)
+	if not score or tonumber(score) < tonumber(ARGV[3]) then
+		activeTicketIDs[#activeTicketIDs + 1] = ticketID
+	end
+end
+for i = 1, #activeTicketIDs, 1000 do
+	local args = {}
+	for j = i, math.min(i + 999, #activeTicketIDs) do
+		args[#args + 1] = ARGV[2]
+		args[#args + 1] = activeTicketIDs[j]
+	end
+	redis.call('ZADD', KEYS[2], unpack(args))
+end
+return activeTicketIDs
+`)
+
+	// KEYS[1]: ticket index, KEYS[2]: pending tickets
+	// ARGV: ticket IDs
+	deindexTicketsScript = rueidis.NewLuaScript(`
+for i = 1, #ARGV, 1000 do
+	local ticketIDs = {unpack(ARGV, i, math.min(i + 999, #ARGV))}
+	redis.call('SREM', KEYS[1], unpack(ticketIDs))
+	redis.call('ZREM', KEYS[2], unpack(ticketIDs))
+end
+return 0
+`)
+)
+
+type ticketIndexRepository struct {
+	client                rueidis.Client
+	pendingReleaseTimeout time.Duration
+}
+
+// NewTicketIndexRepository returns the repository that fetches and deindexes the tickets with Lua scripts.
+// Each script runs atomically in Redis, so the workers do not need to take the fetch lock.
+func NewTicketIndexRepository(
+	client rueidis.Client,
+	pendingReleaseTimeout time.Duration,
+) repository.TicketIndexRepository {
+	return &ticketIndexRepository{
+		client:                client,
+		pendingReleaseTimeout: pendingReleaseTimeout,
+	}
+}
+
+func (r *ticketIndexRepository) FetchActiveTicketIDs(ctx context.Context, limit int64) ([]string, *errs.Error) {
+	now := time.Now()
+
+	resp := fetchActiveTicketIDsScript.Exec(ctx, r.client,
+		[]string{ticketIDKey, pendingTicketKey},
+		[]string{
+			strconv.FormatInt(limit, 10),
+			strconv.FormatInt(now.Unix(), 10),
+			strconv.FormatInt(now.Add(-r.pendingReleaseTimeout).Unix(), 10),
+		},
+	)
+	if err := resp.Error(); err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil, nil
+		}
+		return nil, entity.WithCause(entity.ErrIndexGetFailed, err)
+	}
+
+	activeTicketIDs, err := resp.AsStrSlice()
+	if err != nil {
+		return nil, entity.WithCause(entity.ErrIndexDecodeFailed, err)
+	}
+
+	return activeTicketIDs, nil
+}
+
+func (r *ticketIndexRepository) DeindexTickets(ctx context.Context, ticketIDs []string) *errs.Error {
+	if len(ticketIDs) == 0 {
+		return nil
+	}
+
+	resp := deindexTicketsScript.Exec(ctx, r.client, []string{ticketIDKey, pendingTicketKey}, ticketIDs)
+	if err := resp.Error(); err != nil {
+		return entity.WithCause(entity.ErrIndexDeleteFailed, err)
+	}
+
+	return nil
+}
+
+func (r *ticketIndexRepository) ReleaseTickets(ctx context.Context, ticketIDs []string) *errs.Error {
+	query := r.client.B().Zrem().Key(pendingTicketKey).Member(ticketIDs...).Build()
+
+	if err := r.client.Do(ctx, query).Error(); err != nil {
+		return entity.WithCause(entity.ErrPendingTicketReleaseFailed, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/HMasataka/collision/domain/driver"
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/errs"
+	"github.com/samber/lo"
+)
+
+type lockedTicketIndexRepository struct {
+	lockerDriver            driver.LockerDriver
+	ticketIDRepository      repository.TicketIDRepository
+	pendingTicketRepository repository.PendingTicketRepository
+}
+
+// NewLockedTicketIndexRepository returns the repository that serializes the fetch and the deindex with the fetch lock.
+func NewLockedTicketIndexRepository(
+	lockerDriver driver.LockerDriver,
+	ticketIDRepository repository.TicketIDRepository,
+	pendingTicketRepository repository.PendingTicketRepository,
+) repository.TicketIndexRepository {
+	return &lockedTicketIndexRepository{
+		lockerDriver:            lockerDriver,
+		ticketIDRepository:      ticketIDRepository,
+		pendingTicketRepository: pendingTicketRepository,
+	}
+}
+
+func (r *lockedTicketIndexRepository) FetchActiveTicketIDs(ctx context.Context, limit int64) ([]string, *errs.Error) {
+	// 複数のワーカーが同時にFetchしないようにロックを取得する
+	lockedCtx, unlock, err := r.lockerDriver.FetchTicketLock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	allTicketIDs, err := r.ticketIDRepository.GetAllTicketIDs(lockedCtx, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(allTicketIDs) == 0 {
+		return nil, nil
+	}
+
+	pendingTicketIDs, err := r.pendingTicketRepository.GetPendingTicketIDs(lockedCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	activeTicketIDs, _ := lo.Difference(allTicketIDs, pendingTicketIDs)
+	if len(activeTicketIDs) == 0 {
+		return nil, nil
+	}
+
+	if err := r.pendingTicketRepository.InsertPendingTicket(lockedCtx, activeTicketIDs); err != nil {
+		return nil, err
+	}
+
+	return activeTicketIDs, nil
+}
+
+func (r *lockedTicketIndexRepository) DeindexTickets(ctx context.Context, ticketIDs []string) *errs.Error {
+	// Acquire locks to avoid race condition with FetchActiveTicketIDs.
+	//
+	// Without locks, when the following order,
+	// The assigned ticket is fetched again by the other backend, resulting in overlapping matches.
+	//
+	// 1. (FetchActiveTicketIDs) getAllTicketIDs
+	// 2. (DeindexTickets) ZREM and SREM from ticket index
+	// 3. (FetchActiveTicketIDs) getPendingTicketIDs
+	lockedCtx, unlock, err := r.lockerDriver.FetchTicketLock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := r.pendingTicketRepository.DeletePendingTickets(lockedCtx, ticketIDs); err != nil {
+		return err
+	}
+
+	if err := r.ticketIDRepository.Delete(lockedCtx, ticketIDs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *lockedTicketIndexRepository) ReleaseTickets(ctx context.Context, ticketIDs []string) *errs.Error {
+	lockedCtx, unlock, err := r.lockerDriver.FetchTicketLock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return r.pendingTicketRepository.DeletePendingTickets(lockedCtx, ticketIDs)
+}
@@ -0,0 +1,105 @@
+package persistence
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/HMasataka/collision/config"
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/collision/infrastructure/driver"
+	"github.com/redis/rueidis"
+)
+
+const (
+	benchmarkTickets = 2000
+	benchmarkLimit   = 100
+	// benchmarkWorkers is the number of the concurrent workers per CPU.
+	benchmarkWorkers = 8
+)
+
+// newBenchmarkTicketIndex returns the ticket index repository of the mode with the ticket index filled.
+func newBenchmarkTicketIndex(b *testing.B, mode config.TicketIndexMode) (rueidis.Client, repository.TicketIndexRepository) {
+	b.Helper()
+
+	client := newTestClient(b)
+
+	ctx := context.Background()
+	for i := 0; i < benchmarkTickets; i += 1000 {
+		ids := make([]string, 0, 1000)
+		for j := i; j < i+1000; j++ {
+			ids = append(ids, "ticket"+strconv.Itoa(j))
+		}
+		if err := client.Do(ctx, client.B().Sadd().Key(ticketIDKey).Member(ids...).Build()).Error(); err != nil {
+			b.Fatalf("SADD error = %v", err)
+		}
+	}
+
+	cfg := config.Default()
+	cfg.Redis.TicketIndex = mode
+
+	return client, newRepository(client, driver.NewLockerDriver(newTestLocker(b)), cfg).TicketIndexRepository
+}
+
+var benchmarkModes = []config.TicketIndexMode{config.TicketIndexScript, config.TicketIndexLock}
+
+// BenchmarkFetchActiveTicketIDs fetches the active tickets and releases them concurrently,
+// as the match loop of several workers does for the unmatched tickets.
+func BenchmarkFetchActiveTicketIDs(b *testing.B) {
+	for _, mode := range benchmarkModes {
+		b.Run(string(mode), func(b *testing.B) {
+			_, r := newBenchmarkTicketIndex(b, mode)
+
+			b.SetParallelism(benchmarkWorkers)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				ctx := context.Background()
+				for pb.Next() {
+					ids, err := r.FetchActiveTicketIDs(ctx, benchmarkLimit)
+					if err != nil {
+						b.Errorf("FetchActiveTicketIDs() error = %v", err)
+						return
+					}
+					if err := r.ReleaseTickets(ctx, ids); err != nil {
+						b.Errorf("ReleaseTickets() error = %v", err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
+
+// BenchmarkDeindexTickets fetches the active tickets and deindexes them concurrently, as the match loop does for the matched tickets.
+// The deindexed tickets are added back to the ticket index in the same operation, so that the index does not run out.
+func BenchmarkDeindexTickets(b *testing.B) {
+	for _, mode := range benchmarkModes {
+		b.Run(string(mode), func(b *testing.B) {
+			client, r := newBenchmarkTicketIndex(b, mode)
+
+			b.SetParallelism(benchmarkWorkers)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				ctx := context.Background()
+				for pb.Next() {
+					ids, err := r.FetchActiveTicketIDs(ctx, benchmarkLimit)
+					if err != nil {
+						b.Errorf("FetchActiveTicketIDs() error = %v", err)
+						return
+					}
+					if len(ids) == 0 {
+						continue
+					}
+					if err := r.DeindexTickets(ctx, ids); err != nil {
+						b.Errorf("DeindexTickets() error = %v", err)
+						return
+					}
+					if err := client.Do(ctx, client.B().Sadd().Key(ticketIDKey).Member(ids...).Build()).Error(); err != nil {
+						b.Errorf("SADD error = %v", err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
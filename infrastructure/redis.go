@@ -1,22 +1,23 @@
 package infrastructure
 
 import (
-	"time"
-
+	"github.com/HMasataka/collision/config"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/errs"
 	"github.com/redis/rueidis"
 	"github.com/redis/rueidis/rueidislock"
 )
 
-const DefaultLockTTL = 1000 * time.Millisecond
-
-var clientOption = rueidis.ClientOption{
-	InitAddress:  []string{"127.0.0.1:6379"},
-	Password:     "", // no password set
-	DisableCache: true,
+func clientOption(cfg *config.Config) rueidis.ClientOption {
+	return rueidis.ClientOption{
+		InitAddress:  []string{cfg.Redis.Address},
+		Password:     cfg.Redis.Password,
+		DisableCache: true,
+	}
 }
 
-func NewClient() rueidis.Client {
-	client, err := rueidis.NewClient(clientOption)
+func NewClient(cfg *config.Config) rueidis.Client {
+	client, err := Connect(cfg)
 	if err != nil {
 		panic(err)
 	}
@@ -24,10 +25,21 @@ func NewClient() rueidis.Client {
 	return client
 }
 
-func NewLocker() rueidislock.Locker {
+// Connect returns the client of the Redis in the config, or an error if the Redis cannot be reached.
+func Connect(cfg *config.Config) (rueidis.Client, *errs.Error) {
+	client, err := rueidis.NewClient(clientOption(cfg))
+	if err != nil {
+		return nil, entity.WithCause(entity.ErrRedisConnectFailed, err)
+	}
+
+	return &tracedClient{Client: client}, nil
+}
+
+func NewLocker(cfg *config.Config) rueidislock.Locker {
 	locker, err := rueidislock.NewLocker(
 		rueidislock.LockerOption{
-			ClientOption:   clientOption,
+			ClientOption:   clientOption(cfg),
+			KeyValidity:    cfg.Redis.LockTTL,
 			KeyMajority:    1,    // Use KeyMajority=1 if you have only one Redis instance. Also make sure that all your `Locker`s share the same KeyMajority.
 			NoLoopTracking: true, // Enable this to have better performance if all your Redis are >= 7.0.5.
 		},
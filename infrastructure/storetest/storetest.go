@@ -0,0 +1,565 @@
+// Package storetest provides the conformance suite that every storage backend must pass,
+// so that the in-memory store behaves the same as Redis.
+package storetest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/HMasataka/collision/domain/driver"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/errs"
+)
+
+// PendingReleaseTimeout is the pending release timeout the backends must be configured with.
+const PendingReleaseTimeout = 1 * time.Second
+
+const notifyTimeout = 5 * time.Second
+
+// Backend is the storage under test.
+type Backend struct {
+	Repositories *repository.RepositoryContainer
+	Notifier     driver.AssignmentNotifierDriver
+}
+
+// NewBackend returns an empty backend configured with PendingReleaseTimeout.
+// It is called for every test, and must clean up what it creates with t.Cleanup.
+type NewBackend func(t *testing.T) *Backend
+
+// Run runs the conformance suite against the backends.
+func Run(t *testing.T, newBackend NewBackend) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, b *Backend)
+	}{
+		{name: "Ticket", run: testTicket},
+		{name: "TicketGetTickets", run: testTicketGetTickets},
+		{name: "TicketExpire", run: testTicketExpire},
+		{name: "TicketID", run: testTicketID},
+		{name: "PendingTicket", run: testPendingTicket},
+		{name: "TicketIndex", run: testTicketIndex},
+		{name: "TicketIndexPendingReleaseTimeout", run: testTicketIndexPendingReleaseTimeout},
+		{name: "TicketIndexRefreshPendingTickets", run: testTicketIndexRefreshPendingTickets},
+		{name: "Assignment", run: testAssignment},
+		{name: "Backfill", run: testBackfill},
+		{name: "BackfillUpdate", run: testBackfillUpdate},
+		{name: "AssignmentNotifier", run: testAssignmentNotifier},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, newBackend(t))
+		})
+	}
+}
+
+func newTicket(id string) *entity.Ticket {
+	return &entity.Ticket{
+		ID:           id,
+		SearchFields: &entity.SearchFields{Tags: []string{"mode.ranked"}},
+		Extensions:   []byte(`{"region":"ap-northeast-1"}`),
+		CreatedAt:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func insertTickets(t *testing.T, b *Backend, ids ...string) {
+	t.Helper()
+
+	ctx := context.Background()
+	for _, id := range ids {
+		if err := b.Repositories.TicketRepository.Insert(ctx, newTicket(id), time.Minute); err != nil {
+			t.Fatalf("TicketRepository.Insert(%q) error = %v", id, err)
+		}
+		if err := b.Repositories.TicketIDRepository.Insert(ctx, id); err != nil {
+			t.Fatalf("TicketIDRepository.Insert(%q) error = %v", id, err)
+		}
+	}
+}
+
+func sorted(ids []string) []string {
+	ids = slices.Clone(ids)
+	slices.Sort(ids)
+	return ids
+}
+
+func testTicket(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	r := b.Repositories.TicketRepository
+
+	if _, err := r.Find(ctx, "t1"); !errors.Is(err, entity.ErrTicketNotFound) {
+		t.Errorf("Find() of a missing ticket error = %v, want %v", err, entity.ErrTicketNotFound)
+	}
+
+	want := newTicket("t1")
+	if err := r.Insert(ctx, want, time.Minute); err != nil {
+		t.Fatalf("Insert() error = %v", err)
+	}
+
+	got, err := r.Find(ctx, "t1")
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Find() = %+v, want %+v", got, want)
+	}
+
+	exists, err := r.Exists(ctx, "t1")
+	if err != nil {
+		t.Fatalf("Exists() error = %v", err)
+	}
+	if !exists {
+		t.Error("Exists() = false, want true")
+	}
+
+	if err := r.Delete(ctx, want); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	exists, err = r.Exists(ctx, "t1")
+	if err != nil {
+		t.Fatalf("Exists() error = %v", err)
+	}
+	if exists {
+		t.Error("Exists() after Delete() = true, want false")
+	}
+}
+
+func testTicketGetTickets(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	insertTickets(t, b, "t1", "t2")
+
+	tests := []struct {
+		name        string
+		ids         []string
+		wantFound   []string
+		wantMissing []string
+	}{
+		{name: "all found", ids: []string{"t1", "t2"}, wantFound: []string{"t1", "t2"}},
+		{name: "some missing", ids: []string{"t1", "missing"}, wantFound: []string{"t1"}, wantMissing: []string{"missing"}},
+		{name: "all missing", ids: []string{"missing1", "missing2"}, wantMissing: []string{"missing1", "missing2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tickets, missing, err := b.Repositories.TicketRepository.GetTickets(ctx, tt.ids)
+			if err != nil {
+				t.Fatalf("GetTickets() error = %v", err)
+			}
+
+			var found []string
+			for _, ticket := range tickets {
+				found = append(found, ticket.ID)
+			}
+			if !slices.Equal(sorted(found), sorted(tt.wantFound)) {
+				t.Errorf("GetTickets() found = %v, want %v", found, tt.wantFound)
+			}
+			if !slices.Equal(sorted(missing), sorted(tt.wantMissing)) {
+				t.Errorf("GetTickets() missing = %v, want %v", missing, tt.wantMissing)
+			}
+		})
+	}
+}
+
+func testTicketExpire(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	r := b.Repositories.TicketRepository
+	insertTickets(t, b, "t1", "t2")
+
+	if err := r.Expire(ctx, []string{"t1"}, 100*time.Millisecond); err != nil {
+		t.Fatalf("Expire() error = %v", err)
+	}
+
+	deadline := time.Now().Add(notifyTimeout)
+	for {
+		exists, err := r.Exists(ctx, "t1")
+		if err != nil {
+			t.Fatalf("Exists() error = %v", err)
+		}
+		if !exists {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the expired ticket still exists")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	exists, err := r.Exists(ctx, "t2")
+	if err != nil {
+		t.Fatalf("Exists() error = %v", err)
+	}
+	if !exists {
+		t.Error("the ticket not expired does not exist")
+	}
+}
+
+func testTicketID(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	r := b.Repositories.TicketIDRepository
+	insertTickets(t, b, "t1", "t2", "t3")
+
+	tests := []struct {
+		name    string
+		limit   int64
+		wantLen int
+	}{
+		{name: "under the limit", limit: 10, wantLen: 3},
+		{name: "at the limit", limit: 3, wantLen: 3},
+		{name: "over the limit", limit: 2, wantLen: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, err := r.GetAllTicketIDs(ctx, tt.limit)
+			if err != nil {
+				t.Fatalf("GetAllTicketIDs() error = %v", err)
+			}
+			if len(ids) != tt.wantLen {
+				t.Errorf("GetAllTicketIDs() = %v, want %d IDs", ids, tt.wantLen)
+			}
+		})
+	}
+
+	if err := r.Delete(ctx, []string{"t1", "t2"}); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	count, err := r.CountTicketIDs(ctx)
+	if err != nil {
+		t.Fatalf("CountTicketIDs() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("CountTicketIDs() = %d, want 1", count)
+	}
+}
+
+func testPendingTicket(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	r := b.Repositories.PendingTicketRepository
+
+	if err := r.InsertPendingTicket(ctx, []string{"t1", "t2", "t3"}); err != nil {
+		t.Fatalf("InsertPendingTicket() error = %v", err)
+	}
+	if err := r.DeletePendingTickets(ctx, []string{"t2"}); err != nil {
+		t.Fatalf("DeletePendingTickets() error = %v", err)
+	}
+
+	ids, err := r.GetPendingTicketIDs(ctx)
+	if err != nil {
+		t.Fatalf("GetPendingTicketIDs() error = %v", err)
+	}
+	if want := []string{"t1", "t3"}; !slices.Equal(sorted(ids), want) {
+		t.Errorf("GetPendingTicketIDs() = %v, want %v", ids, want)
+	}
+
+	count, err := r.CountPendingTickets(ctx)
+	if err != nil {
+		t.Fatalf("CountPendingTickets() error = %v", err)
+	}
+	if count != 2 {
+		t.Errorf("CountPendingTickets() = %d, want 2", count)
+	}
+
+	if err := r.RefreshPendingTickets(ctx, []string{"t1", "t2"}); err != nil {
+		t.Fatalf("RefreshPendingTickets() error = %v", err)
+	}
+
+	ids, err = r.GetPendingTicketIDs(ctx)
+	if err != nil {
+		t.Fatalf("GetPendingTicketIDs() error = %v", err)
+	}
+	if want := []string{"t1", "t3"}; !slices.Equal(sorted(ids), want) {
+		t.Errorf("GetPendingTicketIDs() after RefreshPendingTickets() = %v, want %v", ids, want)
+	}
+}
+
+func testTicketIndex(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	r := b.Repositories.TicketIndexRepository
+	insertTickets(t, b, "t1", "t2", "t3")
+
+	steps := []struct {
+		name    string
+		do      func() *errs.Error
+		limit   int64
+		wantIDs []string
+	}{
+		{name: "fetch all", limit: 10, wantIDs: []string{"t1", "t2", "t3"}},
+		{name: "fetched tickets are pending", limit: 10, wantIDs: nil},
+		{
+			name:    "released tickets are fetched again",
+			do:      func() *errs.Error { return r.ReleaseTickets(ctx, []string{"t1", "t2"}) },
+			limit:   10,
+			wantIDs: []string{"t1", "t2"},
+		},
+		{
+			name: "deindexed tickets are never fetched",
+			do: func() *errs.Error {
+				if err := r.DeindexTickets(ctx, []string{"t1"}); err != nil {
+					return err
+				}
+				return r.ReleaseTickets(ctx, []string{"t2", "t3"})
+			},
+			limit:   10,
+			wantIDs: []string{"t2", "t3"},
+		},
+	}
+
+	for _, step := range steps {
+		if step.do != nil {
+			if err := step.do(); err != nil {
+				t.Fatalf("%s: error = %v", step.name, err)
+			}
+		}
+
+		ids, err := r.FetchActiveTicketIDs(ctx, step.limit)
+		if err != nil {
+			t.Fatalf("%s: FetchActiveTicketIDs() error = %v", step.name, err)
+		}
+		if !slices.Equal(sorted(ids), step.wantIDs) {
+			t.Errorf("%s: FetchActiveTicketIDs() = %v, want %v", step.name, ids, step.wantIDs)
+		}
+	}
+
+	count, err := b.Repositories.TicketIDRepository.CountTicketIDs(ctx)
+	if err != nil {
+		t.Fatalf("CountTicketIDs() error = %v", err)
+	}
+	if count != 2 {
+		t.Errorf("CountTicketIDs() after DeindexTickets() = %d, want 2", count)
+	}
+
+	pending, err := b.Repositories.PendingTicketRepository.GetPendingTicketIDs(ctx)
+	if err != nil {
+		t.Fatalf("GetPendingTicketIDs() error = %v", err)
+	}
+	if want := []string{"t2", "t3"}; !slices.Equal(sorted(pending), want) {
+		t.Errorf("GetPendingTicketIDs() = %v, want %v", pending, want)
+	}
+}
+
+func testTicketIndexPendingReleaseTimeout(t *testing.T, b *Backend) {
+	if testing.Short() {
+		t.Skip("waits for the pending release timeout")
+	}
+
+	ctx := context.Background()
+	r := b.Repositories.TicketIndexRepository
+	insertTickets(t, b, "t1")
+
+	if _, err := r.FetchActiveTicketIDs(ctx, 10); err != nil {
+		t.Fatalf("FetchActiveTicketIDs() error = %v", err)
+	}
+
+	// The pending tickets are scored in seconds.
+	time.Sleep(PendingReleaseTimeout + 2*time.Second)
+
+	ids, err := r.FetchActiveTicketIDs(ctx, 10)
+	if err != nil {
+		t.Fatalf("FetchActiveTicketIDs() error = %v", err)
+	}
+	if want := []string{"t1"}; !slices.Equal(ids, want) {
+		t.Errorf("FetchActiveTicketIDs() after the timeout = %v, want %v", ids, want)
+	}
+}
+
+func testTicketIndexRefreshPendingTickets(t *testing.T, b *Backend) {
+	if testing.Short() {
+		t.Skip("waits for the pending release timeout")
+	}
+
+	ctx := context.Background()
+	r := b.Repositories.TicketIndexRepository
+	insertTickets(t, b, "t1", "t2")
+
+	if _, err := r.FetchActiveTicketIDs(ctx, 10); err != nil {
+		t.Fatalf("FetchActiveTicketIDs() error = %v", err)
+	}
+
+	// The pending tickets are scored in seconds: t2 is released after two seconds,
+	// while t1 refreshed less than a second before the fetch is not.
+	time.Sleep(PendingReleaseTimeout + 500*time.Millisecond)
+	if err := b.Repositories.PendingTicketRepository.RefreshPendingTickets(ctx, []string{"t1"}); err != nil {
+		t.Fatalf("RefreshPendingTickets() error = %v", err)
+	}
+	time.Sleep(600 * time.Millisecond)
+
+	ids, err := r.FetchActiveTicketIDs(ctx, 10)
+	if err != nil {
+		t.Fatalf("FetchActiveTicketIDs() error = %v", err)
+	}
+	if want := []string{"t2"}; !slices.Equal(ids, want) {
+		t.Errorf("FetchActiveTicketIDs() after the timeout = %v, want %v", ids, want)
+	}
+}
+
+func testAssignment(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	r := b.Repositories.AssignmentRepository
+
+	want := &entity.Assignment{Connection: "10.0.0.1:7777", Extensions: []byte("ext")}
+	if err := r.Insert(ctx, []string{"t1", "t2"}, want, time.Minute); err != nil {
+		t.Fatalf("Insert() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		ticketID string
+		want     *entity.Assignment
+		wantErr  error
+	}{
+		{name: "first ticket", ticketID: "t1", want: want},
+		{name: "second ticket", ticketID: "t2", want: want},
+		{name: "not assigned", ticketID: "t3", wantErr: entity.ErrAssignmentNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.Find(ctx, tt.ticketID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Find() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Find() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Find() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func newBackfill(id string) *entity.Backfill {
+	return &entity.Backfill{
+		ID:           id,
+		SearchFields: &entity.SearchFields{Tags: []string{"mode.ranked"}},
+		CreateTime:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Generation:   1,
+	}
+}
+
+func testBackfill(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	r := b.Repositories.BackfillRepository
+
+	if _, err := r.Find(ctx, "b1"); !errors.Is(err, entity.ErrBackfillNotFound) {
+		t.Errorf("Find() of a missing backfill error = %v, want %v", err, entity.ErrBackfillNotFound)
+	}
+
+	for _, id := range []string{"b1", "b2"} {
+		if err := r.Insert(ctx, newBackfill(id), time.Minute); err != nil {
+			t.Fatalf("Insert(%q) error = %v", id, err)
+		}
+	}
+
+	got, err := r.Find(ctx, "b1")
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if want := newBackfill("b1"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Find() = %+v, want %+v", got, want)
+	}
+
+	if err := r.Delete(ctx, "b1"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	backfills, err := r.GetBackfills(ctx)
+	if err != nil {
+		t.Fatalf("GetBackfills() error = %v", err)
+	}
+	if want := []string{"b2"}; !slices.Equal(backfills.IDs(), want) {
+		t.Errorf("GetBackfills() = %v, want %v", backfills.IDs(), want)
+	}
+}
+
+func testBackfillUpdate(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	r := b.Repositories.BackfillRepository
+
+	if err := r.Insert(ctx, newBackfill("b1"), time.Minute); err != nil {
+		t.Fatalf("Insert() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		id         string
+		generation int64
+		wantErr    error
+	}{
+		{name: "generation mismatch", id: "b1", generation: 0, wantErr: entity.ErrBackfillGenerationMismatch},
+		{name: "generation match", id: "b1", generation: 1},
+		{name: "missing", id: "missing", generation: 1, wantErr: entity.ErrBackfillNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backfill := newBackfill(tt.id)
+			backfill.Generation = 2
+			backfill.TicketIDs = []string{"t1"}
+
+			err := r.Update(ctx, backfill, tt.generation, time.Minute)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Update() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+
+			got, err := r.Find(ctx, tt.id)
+			if err != nil {
+				t.Fatalf("Find() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, backfill) {
+				t.Errorf("Find() after Update() = %+v, want %+v", got, backfill)
+			}
+		})
+	}
+}
+
+func testAssignmentNotifier(t *testing.T, b *Backend) {
+	ctx := context.Background()
+
+	assignments, unsubscribe, err := b.Notifier.Subscribe(ctx, "t1")
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	defer unsubscribe()
+
+	others, unsubscribeOthers, err := b.Notifier.Subscribe(ctx, "t3")
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	defer unsubscribeOthers()
+
+	want := &entity.Assignment{Connection: "10.0.0.1:7777"}
+	if err := b.Notifier.Publish(ctx, &entity.AssignmentGroup{TicketIds: []string{"t1", "t2"}, Assignment: want}); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	select {
+	case got := <-assignments:
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("received %+v, want %+v", got, want)
+		}
+	case <-time.After(notifyTimeout):
+		t.Fatal("the assignment was not received")
+	}
+
+	select {
+	case got := <-others:
+		t.Errorf("received %+v for a ticket not in the group", got)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,80 @@
+package infrastructure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/HMasataka/collision/tracing"
+	"github.com/redis/rueidis"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedClient starts a span for each round trip to Redis.
+// The subscriptions are not traced, as they last as long as the watches.
+type tracedClient struct {
+	rueidis.Client
+}
+
+func (c *tracedClient) Do(ctx context.Context, cmd rueidis.Completed) rueidis.RedisResult {
+	// The command is recycled by the client after it is sent, so the name is taken first.
+	ctx, span := startRedisSpan(ctx, commandName(&cmd), 1)
+	defer span.End()
+
+	resp := c.Client.Do(ctx, cmd)
+	if err := resp.Error(); failed(err) {
+		tracing.Fail(span, err)
+	}
+
+	return resp
+}
+
+func (c *tracedClient) DoMulti(ctx context.Context, multi ...rueidis.Completed) []rueidis.RedisResult {
+	name := "pipeline"
+	if len(multi) > 0 {
+		name = commandName(&multi[0])
+	}
+
+	ctx, span := startRedisSpan(ctx, name, len(multi))
+	defer span.End()
+
+	resps := c.Client.DoMulti(ctx, multi...)
+	for _, resp := range resps {
+		if err := resp.Error(); failed(err) {
+			tracing.Fail(span, err)
+			break
+		}
+	}
+
+	return resps
+}
+
+func startRedisSpan(ctx context.Context, name string, commands int) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "redis "+name,
+		attribute.String("db.system.name", "redis"),
+		attribute.String("db.operation.name", name),
+		attribute.Int("db.operation.batch.size", commands),
+	)
+}
+
+// failed reports whether the error is a failure rather than a normal reply,
+// such as a missing key or a script that is not cached yet and is sent again by the client.
+func failed(err error) bool {
+	if err == nil || rueidis.IsRedisNil(err) {
+		return false
+	}
+	if redisErr, ok := rueidis.IsRedisErr(err); ok && redisErr.IsNoScript() {
+		return false
+	}
+
+	return true
+}
+
+func commandName(cmd *rueidis.Completed) string {
+	commands := cmd.Commands()
+	if len(commands) == 0 {
+		return "unknown"
+	}
+
+	return strings.ToUpper(commands[0])
+}
@@ -0,0 +1,133 @@
+// Package logging builds the structured logger of the server.
+// The records carry the attributes added to the context, such as the request ID and the ticket ID,
+// and the trace ID and the span ID of the span in the context.
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/HMasataka/collision/config"
+	"github.com/HMasataka/collision/domain/entity"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// New returns the logger writing to w with the level and the format in the config.
+func New(cfg config.LogConfig, w io.Writer) *slog.Logger {
+	options := &slog.HandlerOptions{Level: cfg.SlogLevel()}
+
+	var handler slog.Handler
+	switch cfg.Format {
+	case config.LogFormatText:
+		handler = slog.NewTextHandler(w, options)
+	default:
+		handler = slog.NewJSONHandler(w, options)
+	}
+
+	return slog.New(&contextHandler{Handler: handler})
+}
+
+type contextKey struct{}
+
+// attrs is shared by the context and the contexts derived from it,
+// so that the attributes added by a handler also appear in the log of the request.
+type attrs struct {
+	mutex sync.Mutex
+	attrs []slog.Attr
+}
+
+// NewContext returns the context that carries the attributes in addition to the ones of ctx.
+func NewContext(ctx context.Context, as ...slog.Attr) context.Context {
+	var parent []slog.Attr
+	if a, ok := ctx.Value(contextKey{}).(*attrs); ok {
+		parent = a.get()
+	}
+
+	return context.WithValue(ctx, contextKey{}, &attrs{attrs: append(parent, as...)})
+}
+
+// AddAttrs adds the attributes to the context made by NewContext. It does nothing for the other contexts.
+func AddAttrs(ctx context.Context, as ...slog.Attr) {
+	if a, ok := ctx.Value(contextKey{}).(*attrs); ok {
+		a.mutex.Lock()
+		a.attrs = append(a.attrs, as...)
+		a.mutex.Unlock()
+	}
+}
+
+func (a *attrs) get() []slog.Attr {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return slices.Clone(a.attrs)
+}
+
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if a, ok := ctx.Value(contextKey{}).(*attrs); ok {
+		record.AddAttrs(a.get()...)
+	}
+
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", sc.TraceID().String()),
+			slog.String("span_id", sc.SpanID().String()),
+		)
+	}
+
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *contextHandler) WithAttrs(as []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(as)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+// Error renders the error with the messages of its causes, as errs.Error only reports its own reason.
+func Error(err error) slog.Attr {
+	if err == nil {
+		return slog.Attr{}
+	}
+
+	messages := entity.ErrorMessages(err)
+
+	as := []any{slog.String("message", messages[0])}
+	if len(messages) > 1 {
+		as = append(as, slog.Any("causes", messages[1:]))
+	}
+
+	return slog.Group("error", as...)
+}
+
+func RequestID(id string) slog.Attr {
+	return slog.String("request_id", id)
+}
+
+func TicketID(id string) slog.Attr {
+	return slog.String("ticket_id", id)
+}
+
+func TicketIDs(ids []string) slog.Attr {
+	return slog.Any("ticket_ids", ids)
+}
+
+func MatchID(id string) slog.Attr {
+	return slog.String("match_id", id)
+}
+
+func BackfillID(id string) slog.Attr {
+	return slog.String("backfill_id", id)
+}
+
+func Profile(name string) slog.Attr {
+	return slog.String("profile", name)
+}
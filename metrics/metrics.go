@@ -0,0 +1,101 @@
+// Package metrics defines the Prometheus metrics of the server.
+// They are registered to the default registry and exposed by Handler.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "collision"
+
+var (
+	TicketsCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tickets_created_total",
+		Help:      "Number of tickets created.",
+	})
+	TicketsDeleted = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tickets_deleted_total",
+		Help:      "Number of tickets deleted by the clients.",
+	})
+	TicketsActive = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "tickets_active",
+		Help:      "Number of indexed tickets that are not pending, observed at the start of each fetch.",
+	})
+	TicketsPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "tickets_pending",
+		Help:      "Number of tickets fetched and waiting for the assignment or the release, observed at the start of each fetch.",
+	})
+
+	Matches = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "matches_total",
+		Help:      "Number of matches made by each profile and kept by the evaluator.",
+	}, []string{"profile"})
+
+	MatchFunctionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "match_function_duration_seconds",
+		Help:      "Latency of the match function of each profile.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"profile"})
+	EvaluatorDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "evaluator_duration_seconds",
+		Help:      "Latency of the evaluator.",
+		Buckets:   prometheus.DefBuckets,
+	})
+	AssignerDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "assigner_duration_seconds",
+		Help:      "Latency of the assigner.",
+		Buckets:   prometheus.DefBuckets,
+	})
+	MatchTickDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "match_tick_duration_seconds",
+		Help:      "Duration of each tick of the match loop, from fetching the tickets to assigning the matches.",
+		Buckets:   prometheus.DefBuckets,
+	})
+	LockWaitDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "lock_wait_duration_seconds",
+		Help:      "Time spent acquiring the fetch lock.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	AssignmentWatches = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "assignment_watches",
+		Help:      "Number of streams watching the assignments.",
+	})
+	AssignmentPublishFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "assignment_publish_failures_total",
+		Help:      "Number of assignment groups whose notification to the watchers could not be published.",
+	})
+
+	TicketEventsDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ticket_events_dropped_total",
+		Help:      "Number of ticket lifecycle events that could not be emitted to the sink.",
+	})
+)
+
+// Since returns the seconds elapsed since start, to be observed by the histograms.
+func Since(start time.Time) float64 {
+	return time.Since(start).Seconds()
+}
+
+// Handler serves the metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,110 @@
+// Package tracing sets up the OpenTelemetry tracing of the server
+// and provides the spans and the attributes shared by the layers.
+package tracing
+
+import (
+	"context"
+
+	"github.com/HMasataka/collision/config"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/errs"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	serviceName = "collision"
+	tracerName  = "github.com/HMasataka/collision"
+)
+
+// Setup installs the global tracer provider with the exporter in the config.
+// The spans are created without being exported if the exporter is not set.
+// The returned function exports the spans still buffered and stops the provider, so it must be called on shutdown.
+func Setup(ctx context.Context, cfg config.TracingConfig) (func(ctx context.Context) error, *errs.Error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var option sdktrace.TracerProviderOption
+	switch cfg.Exporter {
+	case config.TracingExporterOTLP:
+		options := []otlptracegrpc.Option{}
+		if cfg.Endpoint != "" {
+			options = append(options, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+
+		exporter, err := otlptracegrpc.New(ctx, options...)
+		if err != nil {
+			return nil, entity.WithCause(entity.ErrTracingSetupFailed, err)
+		}
+		option = sdktrace.WithBatcher(exporter)
+	case config.TracingExporterStdout:
+		exporter, err := stdouttrace.New()
+		if err != nil {
+			return nil, entity.WithCause(entity.ErrTracingSetupFailed, err)
+		}
+		// The spans are written as soon as they end, so that they appear in order with the logs.
+		option = sdktrace.WithSyncer(exporter)
+	default:
+		return func(context.Context) error { return nil }, nil
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, entity.WithCause(entity.ErrTracingSetupFailed, err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		option,
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Start starts a span with the global tracer provider.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// SetAttributes adds the attributes to the span in the context, such as the span of the gRPC method.
+func SetAttributes(ctx context.Context, attrs ...attribute.KeyValue) {
+	trace.SpanFromContext(ctx).SetAttributes(attrs...)
+}
+
+// Fail records the error on the span and marks the span as failed.
+func Fail(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+func TicketID(id string) attribute.KeyValue {
+	return attribute.String("collision.ticket_id", id)
+}
+
+func TicketIDs(ids []string) attribute.KeyValue {
+	return attribute.StringSlice("collision.ticket_ids", ids)
+}
+
+func MatchIDs(ids []string) attribute.KeyValue {
+	return attribute.StringSlice("collision.match_ids", ids)
+}
+
+func BackfillID(id string) attribute.KeyValue {
+	return attribute.String("collision.backfill_id", id)
+}
+
+func Profile(name string) attribute.KeyValue {
+	return attribute.String("collision.match_profile", name)
+}
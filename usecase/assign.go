@@ -7,37 +7,194 @@ import (
 	"time"
 
 	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/domain/repository"
 	"github.com/HMasataka/collision/domain/service"
+	"github.com/HMasataka/collision/metrics"
 	"github.com/HMasataka/errs"
 	"github.com/sethvargo/go-retry"
 )
 
 type AssignUsecase interface {
-	Watch(ctx context.Context, ticketID string, onAssignmentChanged func(*entity.Assignment) error) *errs.Error
+	Watch(ctx context.Context, ticketID string, oneShot bool, onAssignmentChanged func(*entity.Assignment) error) *errs.Error
 }
 
 type assignUsecase struct {
-	assignerService service.AssignerService
+	ticketRepository repository.TicketRepository
+	assignerService  service.AssignerService
 }
 
 func NewAssignUsecase(
+	repositoryContainer *repository.RepositoryContainer,
 	assignerService service.AssignerService,
 ) AssignUsecase {
 	return &assignUsecase{
-		assignerService: assignerService,
+		ticketRepository: repositoryContainer.TicketRepository,
+		assignerService:  assignerService,
 	}
 }
 
 const (
 	watchAssignmentInterval = 100 * time.Millisecond
+	resubscribeInterval     = 5 * time.Second
+	ticketCheckInterval     = 5 * time.Second
 )
 
-func (u *assignUsecase) Watch(ctx context.Context, ticketID string, onAssignmentChanged func(*entity.Assignment) error) *errs.Error {
-	var prev *entity.Assignment
+// assignmentWatcher calls onAssignmentChanged only when the assignment differs from the previous one.
+type assignmentWatcher struct {
+	prev                *entity.Assignment
+	oneShot             bool
+	done                bool
+	checkedAt           time.Time
+	onAssignmentChanged func(*entity.Assignment) error
+}
+
+func (w *assignmentWatcher) notify(assignment *entity.Assignment) error {
+	if (w.prev == nil && assignment != nil) || !reflect.DeepEqual(w.prev, assignment) {
+		w.prev = assignment
+		if err := w.onAssignmentChanged(assignment); err != nil {
+			return err
+		}
+		w.done = w.oneShot && assignment != nil
+	}
+
+	return nil
+}
+
+// Watch receives the assignments published to the ticket.
+// While the subscription is unavailable, it falls back to polling and tries to subscribe again periodically.
+// The watch ends when the ticket is deleted or expired, or once an assignment has been delivered in one-shot mode.
+func (u *assignUsecase) Watch(ctx context.Context, ticketID string, oneShot bool, onAssignmentChanged func(*entity.Assignment) error) *errs.Error {
+	exists, err := u.ticketRepository.Exists(ctx, ticketID)
+	if err != nil {
+		return entity.WithCause(entity.ErrAssignmentWatchFailed, err)
+	}
+	if !exists {
+		return entity.ErrTicketNotFound
+	}
+
+	metrics.AssignmentWatches.Inc()
+	defer metrics.AssignmentWatches.Dec()
+
+	w := &assignmentWatcher{
+		oneShot:             oneShot,
+		checkedAt:           time.Now(),
+		onAssignmentChanged: onAssignmentChanged,
+	}
+
+	for !w.done {
+		assignments, unsubscribe, err := u.assignerService.SubscribeAssignment(ctx, ticketID)
+		if err == nil {
+			dropped, err := u.receive(ctx, ticketID, assignments, w)
+			unsubscribe()
+			if !dropped {
+				return err
+			}
+		}
+
+		if err := u.poll(ctx, ticketID, w, resubscribeInterval); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkTicket ends the watch if the ticket no longer exists.
+// An expiration after an assignment has been delivered is the normal end of the ticket, so it is not an error.
+func (u *assignUsecase) checkTicket(ctx context.Context, ticketID string, w *assignmentWatcher) *errs.Error {
+	w.checkedAt = time.Now()
+
+	exists, err := u.ticketRepository.Exists(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	w.done = true
+	if w.prev != nil {
+		return nil
+	}
+
+	return entity.ErrTicketGone
+}
+
+// receive delivers the assignments from the subscription and reports whether the subscription was dropped.
+func (u *assignUsecase) receive(ctx context.Context, ticketID string, assignments <-chan *entity.Assignment, w *assignmentWatcher) (bool, *errs.Error) {
+	// The assignment may have been set before subscribing.
+	if err := u.refresh(ctx, ticketID, w); err != nil {
+		return false, err
+	}
+
+	ticker := time.NewTicker(ticketCheckInterval)
+	defer ticker.Stop()
+
+	for !w.done {
+		select {
+		case <-ctx.Done():
+			return false, entity.WithCause(entity.ErrAssignmentWatchFailed, ctx.Err())
+		case <-ticker.C:
+			if err := u.checkTicket(ctx, ticketID, w); err != nil {
+				return false, err
+			}
+			if w.done {
+				break
+			}
+
+			// The notification is best effort, so the assignment is also read in case it was lost.
+			if err := u.refresh(ctx, ticketID, w); err != nil {
+				return false, err
+			}
+		case assignment, ok := <-assignments:
+			if !ok {
+				return true, nil
+			}
+
+			if err := w.notify(assignment); err != nil {
+				return false, entity.WithCause(entity.ErrAssignmentWatchFailed, err)
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// refresh reads the stored assignment and delivers it if it has changed.
+func (u *assignUsecase) refresh(ctx context.Context, ticketID string, w *assignmentWatcher) *errs.Error {
+	assignment, err := u.assignerService.GetAssignment(ctx, ticketID)
+	if err != nil && !errors.Is(err, entity.ErrAssignmentNotFound) {
+		return entity.WithCause(entity.ErrAssignmentWatchFailed, err)
+	}
+	if assignment == nil {
+		return nil
+	}
+
+	if err := w.notify(assignment); err != nil {
+		return entity.WithCause(entity.ErrAssignmentWatchFailed, err)
+	}
+
+	return nil
+}
+
+// poll gets the assignment periodically for the duration.
+func (u *assignUsecase) poll(ctx context.Context, ticketID string, w *assignmentWatcher, duration time.Duration) *errs.Error {
+	pollCtx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
 
 	backoff := newWatchAssignmentBackoff()
 
-	if err := retry.Do(ctx, backoff, func(ctx context.Context) error {
+	var checkErr *errs.Error
+	if err := retry.Do(pollCtx, backoff, func(ctx context.Context) error {
+		if time.Since(w.checkedAt) >= ticketCheckInterval {
+			if checkErr = u.checkTicket(ctx, ticketID, w); checkErr != nil {
+				return checkErr
+			}
+			if w.done {
+				return nil
+			}
+		}
+
 		assignment, err := u.assignerService.GetAssignment(ctx, ticketID)
 		if err != nil {
 			if errors.Is(err, entity.ErrAssignmentNotFound) {
@@ -46,16 +203,22 @@ func (u *assignUsecase) Watch(ctx context.Context, ticketID string, onAssignment
 			return err
 		}
 
-		if (prev == nil && assignment != nil) || !reflect.DeepEqual(prev, assignment) {
-			prev = assignment
-			if err := onAssignmentChanged(assignment); err != nil {
-				return err
-			}
+		if err := w.notify(assignment); err != nil {
+			return err
+		}
+		if w.done {
+			return nil
 		}
 
 		return retry.RetryableError(errs.New("assignment unchanged"))
 	}); err != nil {
-		return entity.ErrAssignmentWatchFailed.WithCause(err)
+		if checkErr != nil {
+			return checkErr
+		}
+		if ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded) {
+			return nil
+		}
+		return entity.WithCause(entity.ErrAssignmentWatchFailed, err)
 	}
 
 	return nil
@@ -2,24 +2,49 @@ package usecase
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"log/slog"
 
+	"github.com/HMasataka/collision/config"
 	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/logging"
+	"github.com/HMasataka/errs"
 	"github.com/bojand/hri"
 )
 
-func NewRandomAssigner() entity.Assigner {
+// NewAssigner returns the remote assigner in the config, or the random assigner if it is not set.
+func NewAssigner(cfg *config.Config, logger *slog.Logger) (entity.Assigner, *errs.Error) {
+	remote := cfg.Match.Assigner
+	if remote == nil {
+		return NewRandomAssigner(logger), nil
+	}
+
+	conn, err := dialRemote(remote.Address)
+	if err != nil {
+		return nil, entity.WithCause(entity.ErrConfigInvalid, fmt.Errorf("assigner: %w", err))
+	}
+
+	return NewRemoteAssigner(conn, remote.Timeout, *remote.MaxRetries), nil
+}
+
+func NewRandomAssigner(logger *slog.Logger) entity.Assigner {
 	return entity.AssignerFunc(func(ctx context.Context, matches entity.Matches) ([]*entity.AssignmentGroup, error) {
 		var asgs []*entity.AssignmentGroup
 
 		for _, match := range matches {
 			ticketIDs := match.Tickets.IDs()
 			conn := hri.Random()
-			log.Printf("assign '%s' to tickets: %v", conn, ticketIDs)
+			logger.InfoContext(ctx, "assigned match",
+				logging.MatchID(match.MatchID),
+				logging.Profile(match.MatchProfile),
+				logging.TicketIDs(ticketIDs),
+				slog.String("connection", conn),
+			)
 
 			asgs = append(asgs, &entity.AssignmentGroup{
-				TicketIds:  ticketIDs,
-				Assignment: &entity.Assignment{Connection: conn},
+				TicketIds: ticketIDs,
+				// The match extensions such as the team composition are passed to the players and the game server.
+				Assignment: &entity.Assignment{Connection: conn, Extensions: match.Extensions},
 			})
 		}
 
@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/collision/domain/service"
+	"github.com/HMasataka/errs"
+	"github.com/rs/xid"
+	"github.com/sethvargo/go-retry"
+)
+
+type BackfillUsecase interface {
+	CreateBackfill(ctx context.Context, searchFields *entity.SearchFields, extensions []byte) (*entity.Backfill, *errs.Error)
+	GetBackfill(ctx context.Context, backfillID string) (*entity.Backfill, *errs.Error)
+	UpdateBackfill(ctx context.Context, backfillID string, searchFields *entity.SearchFields, extensions []byte) (*entity.Backfill, *errs.Error)
+	DeleteBackfill(ctx context.Context, backfillID string) *errs.Error
+	AcknowledgeBackfill(ctx context.Context, backfillID string, assignment *entity.Assignment) (*entity.Backfill, entity.Tickets, *errs.Error)
+}
+
+type backfillUsecase struct {
+	backfillRepository repository.BackfillRepository
+	ticketRepository   repository.TicketRepository
+	ticketService      service.TicketService
+	assignerService    service.AssignerService
+	backfillTTL        time.Duration
+}
+
+func NewBackfillUsecase(
+	repositoryContainer *repository.RepositoryContainer,
+	ticketService service.TicketService,
+	assignerService service.AssignerService,
+	backfillTTL time.Duration,
+) BackfillUsecase {
+	return &backfillUsecase{
+		backfillRepository: repositoryContainer.BackfillRepository,
+		ticketRepository:   repositoryContainer.TicketRepository,
+		ticketService:      ticketService,
+		assignerService:    assignerService,
+		backfillTTL:        backfillTTL,
+	}
+}
+
+const (
+	backfillUpdateInterval   = 10 * time.Millisecond
+	backfillUpdateMaxRetries = 10
+)
+
+func (u *backfillUsecase) CreateBackfill(ctx context.Context, searchFields *entity.SearchFields, extensions []byte) (*entity.Backfill, *errs.Error) {
+	backfill := &entity.Backfill{
+		ID:           xid.New().String(),
+		SearchFields: searchFields,
+		Extensions:   extensions,
+		CreateTime:   time.Now(),
+		Generation:   1,
+	}
+
+	if err := u.backfillRepository.Insert(ctx, backfill, u.backfillTTL); err != nil {
+		return nil, err
+	}
+
+	return backfill, nil
+}
+
+func (u *backfillUsecase) GetBackfill(ctx context.Context, backfillID string) (*entity.Backfill, *errs.Error) {
+	return u.backfillRepository.Find(ctx, backfillID)
+}
+
+// UpdateBackfill replaces the search fields and the extensions of the backfill.
+// The tickets matched into the backfill but not acknowledged yet are released,
+// as they were matched against the state before the update.
+func (u *backfillUsecase) UpdateBackfill(ctx context.Context, backfillID string, searchFields *entity.SearchFields, extensions []byte) (*entity.Backfill, *errs.Error) {
+	old, backfill, err := u.modify(ctx, backfillID, func(backfill *entity.Backfill) {
+		backfill.SearchFields = searchFields
+		backfill.Extensions = extensions
+		backfill.TicketIDs = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(old.TicketIDs) > 0 {
+		if err := u.ticketService.ReleaseTickets(ctx, old.TicketIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	return backfill, nil
+}
+
+// DeleteBackfill deletes the backfill and releases the tickets matched into it.
+func (u *backfillUsecase) DeleteBackfill(ctx context.Context, backfillID string) *errs.Error {
+	backfill, err := u.backfillRepository.Find(ctx, backfillID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.backfillRepository.Delete(ctx, backfillID); err != nil {
+		return err
+	}
+
+	if len(backfill.TicketIDs) > 0 {
+		if err := u.ticketService.ReleaseTickets(ctx, backfill.TicketIDs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AcknowledgeBackfill assigns the connection of the game server to the tickets matched into the backfill,
+// and returns them. It also keeps the backfill alive for another TTL.
+// The tickets that have been deleted or assigned in the meantime are not returned.
+func (u *backfillUsecase) AcknowledgeBackfill(ctx context.Context, backfillID string, assignment *entity.Assignment) (*entity.Backfill, entity.Tickets, *errs.Error) {
+	old, backfill, err := u.modify(ctx, backfillID, func(backfill *entity.Backfill) {
+		backfill.TicketIDs = nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(old.TicketIDs) == 0 {
+		return backfill, nil, nil
+	}
+
+	tickets, deletedTicketIDs, err := u.ticketRepository.GetTickets(ctx, old.TicketIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(deletedTicketIDs) > 0 {
+		if err := u.ticketService.DeleteExpiredTickets(ctx, deletedTicketIDs); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	tickets, err = u.unassignedTickets(ctx, tickets)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(tickets) == 0 {
+		return backfill, nil, nil
+	}
+
+	asgs := []*entity.AssignmentGroup{{TicketIds: tickets.IDs(), Assignment: assignment}}
+	notAssigned, err := u.assignerService.AssignTickets(ctx, asgs)
+	if len(notAssigned) > 0 {
+		if err := u.ticketService.ReleaseTickets(ctx, notAssigned); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err != nil {
+		return nil, nil, entity.WithCause(entity.ErrMatchAssignFailed, err)
+	}
+
+	for _, ticket := range tickets {
+		ticket.Assignment = assignment
+	}
+
+	return backfill, tickets, nil
+}
+
+// unassignedTickets drops the tickets that already have an assignment, so that they are never assigned twice.
+func (u *backfillUsecase) unassignedTickets(ctx context.Context, tickets entity.Tickets) (entity.Tickets, *errs.Error) {
+	unassigned := make(entity.Tickets, 0, len(tickets))
+
+	for _, ticket := range tickets {
+		_, err := u.assignerService.GetAssignment(ctx, ticket.ID)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, entity.ErrAssignmentNotFound) {
+			return nil, err
+		}
+
+		unassigned = append(unassigned, ticket)
+	}
+
+	return unassigned, nil
+}
+
+// modify applies the change to the stored backfill and increments the generation.
+// It retries when the backfill is changed concurrently, for example by the match loop filling it.
+func (u *backfillUsecase) modify(ctx context.Context, backfillID string, change func(*entity.Backfill)) (*entity.Backfill, *entity.Backfill, *errs.Error) {
+	var old, updated *entity.Backfill
+
+	backoff := retry.WithMaxRetries(backfillUpdateMaxRetries, retry.NewConstant(backfillUpdateInterval))
+
+	var modifyErr *errs.Error
+	if err := retry.Do(ctx, backoff, func(ctx context.Context) error {
+		stored, err := u.backfillRepository.Find(ctx, backfillID)
+		if err != nil {
+			modifyErr = err
+			return err
+		}
+
+		backfill := *stored
+		change(&backfill)
+		backfill.Generation = stored.Generation + 1
+
+		if err := u.backfillRepository.Update(ctx, &backfill, stored.Generation, u.backfillTTL); err != nil {
+			modifyErr = err
+			if errors.Is(err, entity.ErrBackfillGenerationMismatch) {
+				return retry.RetryableError(err)
+			}
+			return err
+		}
+
+		old, updated = stored, &backfill
+		modifyErr = nil
+
+		return nil
+	}); err != nil {
+		if modifyErr != nil {
+			return nil, nil, modifyErr
+		}
+		return nil, nil, entity.WithCause(entity.ErrBackfillSetFailed, err)
+	}
+
+	return old, updated, nil
+}
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/HMasataka/collision/domain/entity"
+)
+
+func createTestTickets(t *testing.T, u *testUseCases, n int) entity.Tickets {
+	t.Helper()
+
+	tickets := make(entity.Tickets, 0, n)
+	for range n {
+		ticket, err := u.TicketUsecase.CreateTicket(context.Background(), &entity.SearchFields{}, nil, nil)
+		if err != nil {
+			t.Fatalf("CreateTicket() error = %v", err)
+		}
+		tickets = append(tickets, ticket)
+	}
+
+	return tickets
+}
+
+func TestAcknowledgeBackfillSkipsAssignedTickets(t *testing.T) {
+	ctx := context.Background()
+	u := newTestUseCases(t, nil)
+	tickets := createTestTickets(t, u, 3)
+
+	backfill, err := u.BackfillUsecase.CreateBackfill(ctx, &entity.SearchFields{}, nil)
+	if err != nil {
+		t.Fatalf("CreateBackfill() error = %v", err)
+	}
+
+	filled := *backfill
+	filled.TicketIDs = tickets.IDs()
+	filled.Generation++
+	if err := u.repositories.BackfillRepository.Update(ctx, &filled, backfill.Generation, 0); err != nil {
+		t.Fatalf("BackfillRepository.Update() error = %v", err)
+	}
+
+	// tickets[0] has been assigned by another match, and tickets[1] has been deleted.
+	other := &entity.Assignment{Connection: "other"}
+	if _, err := u.assignerService.AssignTickets(ctx, []*entity.AssignmentGroup{{TicketIds: []string{tickets[0].ID}, Assignment: other}}); err != nil {
+		t.Fatalf("AssignTickets() error = %v", err)
+	}
+	if err := u.TicketUsecase.DeleteTicket(ctx, tickets[1].ID); err != nil {
+		t.Fatalf("DeleteTicket() error = %v", err)
+	}
+
+	assignment := &entity.Assignment{Connection: "backfill"}
+	_, acknowledged, err := u.BackfillUsecase.AcknowledgeBackfill(ctx, backfill.ID, assignment)
+	if err != nil {
+		t.Fatalf("AcknowledgeBackfill() error = %v", err)
+	}
+
+	if got, want := acknowledged.IDs(), []string{tickets[2].ID}; !slices.Equal(got, want) {
+		t.Errorf("AcknowledgeBackfill() tickets = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		name     string
+		ticketID string
+		want     string
+	}{
+		{name: "assigned by another match", ticketID: tickets[0].ID, want: other.Connection},
+		{name: "assigned by the backfill", ticketID: tickets[2].ID, want: assignment.Connection},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := u.assignerService.GetAssignment(ctx, tt.ticketID)
+			if err != nil {
+				t.Fatalf("GetAssignment() error = %v", err)
+			}
+			if got.Connection != tt.want {
+				t.Errorf("Connection = %q, want %q", got.Connection, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecDropsNewBackfills(t *testing.T) {
+	ctx := context.Background()
+
+	profile := &entity.MatchProfile{Name: "backfill", Pools: []*entity.Pool{{Name: "all"}}}
+	mmf := entity.MatchFunctionFunc(func(ctx context.Context, profile *entity.MatchProfile, poolTickets map[string]entity.Tickets, poolBackfills map[string]entity.Backfills) (entity.Matches, error) {
+		return entity.Matches{{
+			MatchID:      "match",
+			MatchProfile: profile.Name,
+			Tickets:      poolTickets["all"],
+			Backfill:     &entity.Backfill{SearchFields: &entity.SearchFields{}},
+		}}, nil
+	})
+
+	u := newTestUseCases(t, map[*entity.MatchProfile]entity.MatchFunction{profile: mmf})
+	tickets := createTestTickets(t, u, 2)
+
+	if err := u.MatchUsecase.Exec(ctx, nil, nil); err != nil {
+		t.Fatalf("Exec() error = %v", err)
+	}
+
+	backfills, err := u.repositories.BackfillRepository.GetBackfills(ctx)
+	if err != nil {
+		t.Fatalf("GetBackfills() error = %v", err)
+	}
+	if len(backfills) != 0 {
+		t.Errorf("GetBackfills() = %v, want no backfills", backfills.IDs())
+	}
+
+	pending, err := u.repositories.PendingTicketRepository.GetPendingTicketIDs(ctx)
+	if err != nil {
+		t.Fatalf("GetPendingTicketIDs() error = %v", err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("GetPendingTicketIDs() = %v, want the tickets %v released", pending, tickets.IDs())
+	}
+}
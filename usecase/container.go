@@ -1,17 +1,21 @@
 package usecase
 
 import (
+	"log/slog"
 	"sync"
 
+	"github.com/HMasataka/collision/config"
 	"github.com/HMasataka/collision/domain/entity"
 	"github.com/HMasataka/collision/domain/repository"
 	"github.com/HMasataka/collision/domain/service"
 )
 
 type UseCaseContainer struct {
-	MatchUsecase  MatchUsecase
-	TicketUsecase TicketUsecase
-	AssignUsecase AssignUsecase
+	MatchUsecase    MatchUsecase
+	TicketUsecase   TicketUsecase
+	AssignUsecase   AssignUsecase
+	ProfileUsecase  ProfileUsecase
+	BackfillUsecase BackfillUsecase
 }
 
 var (
@@ -20,31 +24,41 @@ var (
 )
 
 func NewUseCaseOnce(
+	registry MatchFunctionRegistry,
 	matchFunctions map[*entity.MatchProfile]entity.MatchFunction,
 	assigner entity.Assigner,
 	evaluator entity.Evaluator,
 	repositoryContainer *repository.RepositoryContainer,
 	ticketService service.TicketService,
 	assignerService service.AssignerService,
+	cfg *config.Config,
+	logger *slog.Logger,
 ) *UseCaseContainer {
 	once.Do(func() {
-		container = newContainer(matchFunctions, assigner, evaluator, repositoryContainer, ticketService, assignerService)
+		container = newContainer(registry, matchFunctions, assigner, evaluator, repositoryContainer, ticketService, assignerService, cfg, logger)
 	})
 
 	return container
 }
 
 func newContainer(
+	registry MatchFunctionRegistry,
 	matchFunctions map[*entity.MatchProfile]entity.MatchFunction,
 	assigner entity.Assigner,
 	evaluator entity.Evaluator,
 	repositoryContainer *repository.RepositoryContainer,
 	ticketService service.TicketService,
 	assignerService service.AssignerService,
+	cfg *config.Config,
+	logger *slog.Logger,
 ) *UseCaseContainer {
+	matchUsecase := NewMatchUsecase(matchFunctions, assigner, evaluator, repositoryContainer, ticketService, assignerService, cfg.Match.FetchLimit, cfg.Backfill.TTL, logger)
+
 	return &UseCaseContainer{
-		MatchUsecase:  NewMatchUsecase(matchFunctions, assigner, evaluator, repositoryContainer, ticketService, assignerService),
-		TicketUsecase: NewTicketUsecase(ticketService),
-		AssignUsecase: NewAssignUsecase(assignerService),
+		MatchUsecase:    matchUsecase,
+		TicketUsecase:   NewTicketUsecase(repositoryContainer, ticketService, assignerService, cfg.Ticket.TTL),
+		AssignUsecase:   NewAssignUsecase(repositoryContainer, assignerService),
+		ProfileUsecase:  NewProfileUsecase(cfg, registry, matchUsecase),
+		BackfillUsecase: NewBackfillUsecase(repositoryContainer, ticketService, assignerService, cfg.Backfill.TTL),
 	}
 }
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/HMasataka/collision/config"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/errs"
+)
+
+// NewEvaluator returns the remote evaluator in the config, or the default evaluator if it is not set.
+func NewEvaluator(cfg *config.Config) (entity.Evaluator, *errs.Error) {
+	remote := cfg.Match.Evaluator
+	if remote == nil {
+		return NewDefaultEvaluator(), nil
+	}
+
+	conn, err := dialRemote(remote.Address)
+	if err != nil {
+		return nil, entity.WithCause(entity.ErrConfigInvalid, fmt.Errorf("evaluator: %w", err))
+	}
+
+	return NewRemoteEvaluator(conn, remote.Timeout, *remote.MaxRetries), nil
+}
+
+// NewDefaultEvaluator keeps the matches that do not share a ticket or a backfill with a better match.
+// Matches are compared by the normalized score, then by the oldest ticket, then by the match ID,
+// so that the result does not depend on the order the match functions returned them.
+func NewDefaultEvaluator() entity.Evaluator {
+	return entity.EvaluatorFunc(func(ctx context.Context, matches []*entity.Match) ([]string, error) {
+		type candidate struct {
+			match  *entity.Match
+			score  float64
+			oldest time.Time
+		}
+
+		scores := normalizeScores(matches)
+
+		candidates := make([]candidate, 0, len(matches))
+		for i, match := range matches {
+			c := candidate{match: match, score: scores[i]}
+			for _, ticket := range match.Tickets {
+				if c.oldest.IsZero() || ticket.CreatedAt.Before(c.oldest) {
+					c.oldest = ticket.CreatedAt
+				}
+			}
+			candidates = append(candidates, c)
+		}
+
+		slices.SortFunc(candidates, func(a, b candidate) int {
+			if c := cmp.Compare(b.score, a.score); c != 0 {
+				return c
+			}
+			if c := a.oldest.Compare(b.oldest); c != 0 {
+				return c
+			}
+			return strings.Compare(a.match.MatchID, b.match.MatchID)
+		})
+
+		usedTickets := map[string]struct{}{}
+		usedBackfills := map[string]struct{}{}
+		var matchIDs []string
+
+		for _, c := range candidates {
+			if overlaps(c.match, usedTickets, usedBackfills) {
+				continue
+			}
+
+			for _, id := range c.match.Tickets.IDs() {
+				usedTickets[id] = struct{}{}
+			}
+			if c.match.Backfill != nil && c.match.Backfill.ID != "" {
+				usedBackfills[c.match.Backfill.ID] = struct{}{}
+			}
+
+			matchIDs = append(matchIDs, c.match.MatchID)
+		}
+
+		return matchIDs, nil
+	})
+}
+
+// normalizeScores scales the scores of the matches to [0, 1] within each profile,
+// because the match functions score on their own scales: 1 is the best proposal of the profile and 0 the worst.
+// The matches of a profile whose proposals all have the same score are scored 1, as the profile prefers none of them.
+func normalizeScores(matches []*entity.Match) []float64 {
+	type bounds struct {
+		min, max float64
+	}
+
+	profiles := map[string]bounds{}
+	for _, match := range matches {
+		score := match.Score()
+		b, ok := profiles[match.MatchProfile]
+		if !ok {
+			b = bounds{min: score, max: score}
+		}
+		profiles[match.MatchProfile] = bounds{min: min(b.min, score), max: max(b.max, score)}
+	}
+
+	scores := make([]float64, len(matches))
+	for i, match := range matches {
+		b := profiles[match.MatchProfile]
+		if b.max == b.min {
+			scores[i] = 1
+			continue
+		}
+		scores[i] = (match.Score() - b.min) / (b.max - b.min)
+	}
+
+	return scores
+}
+
+func overlaps(match *entity.Match, usedTickets, usedBackfills map[string]struct{}) bool {
+	if match.Backfill != nil && match.Backfill.ID != "" {
+		if _, ok := usedBackfills[match.Backfill.ID]; ok {
+			return true
+		}
+	}
+
+	for _, id := range match.Tickets.IDs() {
+		if _, ok := usedTickets[id]; ok {
+			return true
+		}
+	}
+
+	return false
+}
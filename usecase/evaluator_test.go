@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+)
+
+func TestDefaultEvaluator(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newMatch := func(id, profile, score string, createdAt time.Time, ticketIDs ...string) *entity.Match {
+		match := &entity.Match{MatchID: id, MatchProfile: profile}
+		if score != "" {
+			match.Extensions = []byte(`{"score":` + score + `}`)
+		}
+		for _, ticketID := range ticketIDs {
+			match.Tickets = append(match.Tickets, &entity.Ticket{ID: ticketID, CreatedAt: createdAt})
+		}
+		return match
+	}
+
+	tests := []struct {
+		name    string
+		matches []*entity.Match
+		want    []string
+	}{
+		{
+			name: "higher score in the profile wins",
+			matches: []*entity.Match{
+				newMatch("far", "skill", "-300", base, "t1", "t2"),
+				newMatch("close", "skill", "-10", base.Add(time.Second), "t1", "t3"),
+			},
+			want: []string{"close"},
+		},
+		{
+			name: "scores are not compared across profiles",
+			matches: []*entity.Match{
+				newMatch("skill-best", "skill", "-10", base, "t1", "t2"),
+				newMatch("skill-worst", "skill", "-300", base.Add(time.Second), "t3", "t4"),
+				newMatch("simple", "simple", "", base.Add(time.Second), "t2", "t5"),
+			},
+			want: []string{"skill-best", "skill-worst"},
+		},
+		{
+			name: "the best proposals of the profiles fall back to the oldest ticket",
+			matches: []*entity.Match{
+				newMatch("skill", "skill", "-50", base, "t1", "t2"),
+				newMatch("score", "score", "1000", base.Add(time.Second), "t1", "t3"),
+			},
+			want: []string{"skill"},
+		},
+		{
+			name: "match ID breaks the tie",
+			matches: []*entity.Match{
+				newMatch("b", "simple", "", base, "t1", "t2"),
+				newMatch("a", "simple", "", base, "t1", "t3"),
+			},
+			want: []string{"a"},
+		},
+		{
+			name: "matches sharing a backfill",
+			matches: []*entity.Match{
+				{MatchID: "a", MatchProfile: "simple", Backfill: &entity.Backfill{ID: "b1"}, Tickets: entity.Tickets{{ID: "t1", CreatedAt: base}}},
+				{MatchID: "b", MatchProfile: "simple", Backfill: &entity.Backfill{ID: "b1"}, Tickets: entity.Tickets{{ID: "t2", CreatedAt: base}}},
+			},
+			want: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewDefaultEvaluator().Evaluate(context.Background(), tt.matches)
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Evaluate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,31 +2,55 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/HMasataka/collision/domain/entity"
 	"github.com/HMasataka/collision/domain/repository"
 	"github.com/HMasataka/collision/domain/service"
+	"github.com/HMasataka/collision/logging"
+	"github.com/HMasataka/collision/metrics"
+	"github.com/HMasataka/collision/tracing"
 	"github.com/HMasataka/errs"
+	"github.com/rs/xid"
 	"github.com/samber/lo"
 	"golang.org/x/sync/errgroup"
 )
 
 type MatchUsecase interface {
 	Exec(ctx context.Context, searchFields *entity.SearchFields, extensions []byte) *errs.Error
+	FetchMatches(ctx context.Context, profile *entity.MatchProfile) (entity.Matches, *errs.Error)
+	AssignTickets(ctx context.Context, asgs []*entity.AssignmentGroup) ([]string, *errs.Error)
+
+	MatchProfiles(ctx context.Context) []*entity.MatchProfile
+	PutMatchProfile(ctx context.Context, profile *entity.MatchProfile, mmf entity.MatchFunction)
+	DeleteMatchProfile(ctx context.Context, name string) *errs.Error
+	ReplaceMatchProfiles(ctx context.Context, mmfs map[*entity.MatchProfile]entity.MatchFunction)
 }
 
 type matchUsecase struct {
+	// matchFunctions is never modified in place but replaced with a new map,
+	// so that a tick in progress keeps running with the map it has taken.
 	mutex          sync.RWMutex
 	matchFunctions map[*entity.MatchProfile]entity.MatchFunction
 
 	assigner  entity.Assigner
 	evaluator entity.Evaluator
 
-	ticketRepository        repository.TicketRepository
-	pendingTicketRepository repository.PendingTicketRepository
-	ticketService           service.TicketService
-	assignerService         service.AssignerService
+	ticketRepository   repository.TicketRepository
+	backfillRepository repository.BackfillRepository
+	ticketService      service.TicketService
+	assignerService    service.AssignerService
+
+	fetchLimit  int64
+	backfillTTL time.Duration
+
+	logger *slog.Logger
 }
 
 func NewMatchUsecase(
@@ -36,60 +60,234 @@ func NewMatchUsecase(
 	repositoryContainer *repository.RepositoryContainer,
 	ticketService service.TicketService,
 	assignerService service.AssignerService,
+	fetchLimit int64,
+	backfillTTL time.Duration,
+	logger *slog.Logger,
 ) MatchUsecase {
 	return &matchUsecase{
-		mutex:                   sync.RWMutex{},
-		matchFunctions:          matchFunctions,
-		assigner:                assigner,
-		evaluator:               evaluator,
-		ticketRepository:        repositoryContainer.TicketRepository,
-		pendingTicketRepository: repositoryContainer.PendingTicketRepository,
-		ticketService:           ticketService,
-		assignerService:         assignerService,
+		mutex:              sync.RWMutex{},
+		matchFunctions:     matchFunctions,
+		assigner:           assigner,
+		evaluator:          evaluator,
+		ticketRepository:   repositoryContainer.TicketRepository,
+		backfillRepository: repositoryContainer.BackfillRepository,
+		ticketService:      ticketService,
+		assignerService:    assignerService,
+		fetchLimit:         fetchLimit,
+		backfillTTL:        backfillTTL,
+		logger:             logger,
 	}
 }
 
 func (u *matchUsecase) Exec(ctx context.Context, searchFields *entity.SearchFields, extensions []byte) *errs.Error {
-	activeTickets, err := u.fetchActiveTickets(ctx, 10000)
+	defer func(start time.Time) {
+		metrics.MatchTickDuration.Observe(metrics.Since(start))
+	}(time.Now())
+
+	ctx, span := tracing.Start(ctx, "matchUsecase.Exec")
+	defer span.End()
+
+	u.mutex.RLock()
+	mmfs := u.matchFunctions
+	u.mutex.RUnlock()
+
+	matches, err := u.fetchMatches(ctx, mmfs, false)
 	if err != nil {
+		tracing.Fail(span, err)
 		return err
 	}
 
+	// The tickets matched into the backfills are assigned when the game servers acknowledge the backfills.
+	matches = lo.Filter(matches, func(match *entity.Match, _ int) bool {
+		return match.Backfill == nil
+	})
+
+	if len(matches) > 0 {
+		if err := u.assign(ctx, matches); err != nil {
+			tracing.Fail(span, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FetchMatches runs the match function registered for the profile name against the given profile
+// and returns the proposals without assigning them.
+// Matched tickets stay pending until they are assigned by AssignTickets or the pending timeout elapses.
+// The matches with a backfill are already stored in the backfill, and their tickets are assigned by AcknowledgeBackfill.
+func (u *matchUsecase) FetchMatches(ctx context.Context, profile *entity.MatchProfile) (entity.Matches, *errs.Error) {
+	mmf, err := u.findMatchFunction(profile.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.fetchMatches(ctx, map[*entity.MatchProfile]entity.MatchFunction{profile: mmf}, true)
+}
+
+// AssignTickets sets the assignments to the tickets and releases the tickets that could not be assigned.
+func (u *matchUsecase) AssignTickets(ctx context.Context, asgs []*entity.AssignmentGroup) ([]string, *errs.Error) {
+	notAssigned, err := u.assignerService.AssignTickets(ctx, asgs)
+	if len(notAssigned) > 0 {
+		if err := u.ticketService.ReleaseTickets(ctx, notAssigned); err != nil {
+			return notAssigned, err
+		}
+	}
+	if err != nil {
+		return notAssigned, entity.WithCause(entity.ErrMatchAssignFailed, err)
+	}
+
+	return notAssigned, nil
+}
+
+// MatchProfiles returns the profiles the match loop is running with.
+func (u *matchUsecase) MatchProfiles(ctx context.Context) []*entity.MatchProfile {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	profiles := lo.Keys(u.matchFunctions)
+	slices.SortFunc(profiles, func(a, b *entity.MatchProfile) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return profiles
+}
+
+// PutMatchProfile adds the profile, or replaces the profile of the same name.
+// The change takes effect from the next tick.
+func (u *matchUsecase) PutMatchProfile(ctx context.Context, profile *entity.MatchProfile, mmf entity.MatchFunction) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	mmfs := lo.OmitBy(u.matchFunctions, func(p *entity.MatchProfile, _ entity.MatchFunction) bool {
+		return p.Name == profile.Name
+	})
+	mmfs[profile] = mmf
+
+	u.matchFunctions = mmfs
+}
+
+// DeleteMatchProfile removes the profile of the name. The change takes effect from the next tick.
+func (u *matchUsecase) DeleteMatchProfile(ctx context.Context, name string) *errs.Error {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	mmfs := lo.OmitBy(u.matchFunctions, func(p *entity.MatchProfile, _ entity.MatchFunction) bool {
+		return p.Name == name
+	})
+	if len(mmfs) == len(u.matchFunctions) {
+		return entity.ErrMatchProfileNotFound
+	}
+
+	u.matchFunctions = mmfs
+
+	return nil
+}
+
+// ReplaceMatchProfiles replaces all the profiles. The change takes effect from the next tick.
+func (u *matchUsecase) ReplaceMatchProfiles(ctx context.Context, mmfs map[*entity.MatchProfile]entity.MatchFunction) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	u.matchFunctions = maps.Clone(mmfs)
+}
+
+func (u *matchUsecase) findMatchFunction(profileName string) (entity.MatchFunction, *errs.Error) {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	for profile, mmf := range u.matchFunctions {
+		if profile.Name == profileName {
+			return mmf, nil
+		}
+	}
+
+	return nil, entity.ErrMatchFunctionNotFound
+}
+
+// fetchMatches makes the matches from the active tickets and the backfills.
+// A match with a new backfill is only kept if createBackfills is set, because the backfill is not surfaced otherwise.
+func (u *matchUsecase) fetchMatches(ctx context.Context, mmfs map[*entity.MatchProfile]entity.MatchFunction, createBackfills bool) (entity.Matches, *errs.Error) {
+	u.observeTickets(ctx)
+
+	backfills, err := u.backfillRepository.GetBackfills(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The tickets matched into the backfills stay pending until the backfills are acknowledged,
+	// so that they are not fetched again after the pending release timeout.
+	if heldTicketIDs := lo.FlatMap(backfills, func(backfill *entity.Backfill, _ int) []string {
+		return backfill.TicketIDs
+	}); len(heldTicketIDs) > 0 {
+		if err := u.ticketService.HoldTickets(ctx, heldTicketIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	activeTickets, err := u.fetchActiveTickets(ctx, u.fetchLimit)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(activeTickets) == 0 {
-		return nil
+		return nil, nil
 	}
 
-	matches, err := u.makeMatches(ctx, activeTickets)
+	matches, err := u.makeMatches(ctx, mmfs, activeTickets, backfills)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	matches, err = u.evaluateMatches(ctx, matches)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	matches, err = u.applyBackfills(ctx, matches, backfills, createBackfills)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, match := range matches {
+		metrics.Matches.WithLabelValues(match.MatchProfile).Inc()
+		u.logger.DebugContext(ctx, "made match",
+			logging.MatchID(match.MatchID),
+			logging.Profile(match.MatchProfile),
+			logging.TicketIDs(match.Tickets.IDs()),
+		)
 	}
+	u.ticketService.NotifyMatches(ctx, matches)
 
 	unmatchedTicketIDs, _ := lo.Difference(activeTickets.IDs(), matches.TicketIDs())
 	if len(unmatchedTicketIDs) > 0 {
-		if err := u.pendingTicketRepository.ReleaseTickets(ctx, unmatchedTicketIDs); err != nil {
-			return entity.ErrPendingTicketReleaseFailed.WithCause(err)
+		if err := u.ticketService.ReleaseTickets(ctx, unmatchedTicketIDs); err != nil {
+			return nil, err
 		}
 	}
 
-	if len(matches) > 0 {
-		if err := u.assign(ctx, matches); err != nil {
-			return err
-		}
-	}
+	return matches, nil
+}
 
-	return nil
+// observeTickets updates the ticket gauges. A failure only leaves the gauges stale, so the fetch goes on.
+func (u *matchUsecase) observeTickets(ctx context.Context) {
+	active, pending, err := u.ticketService.CountTickets(ctx)
+	if err != nil {
+		return
+	}
 
+	metrics.TicketsActive.Set(float64(active))
+	metrics.TicketsPending.Set(float64(pending))
 }
 
 func (u *matchUsecase) fetchActiveTickets(ctx context.Context, limit int64) (entity.Tickets, *errs.Error) {
+	ctx, span := tracing.Start(ctx, "matchUsecase.fetch")
+	defer span.End()
+
 	activeTicketIDs, err := u.ticketService.GetActiveTicketIDs(ctx, limit)
 	if err != nil {
-		return nil, entity.ErrIndexGetFailed.WithCause(err)
+		tracing.Fail(span, err)
+		return nil, err
 	}
 	if len(activeTicketIDs) == 0 {
 		return nil, nil
@@ -97,22 +295,30 @@ func (u *matchUsecase) fetchActiveTickets(ctx context.Context, limit int64) (ent
 
 	tickets, deletedTicketIDs, err := u.ticketRepository.GetTickets(ctx, activeTicketIDs)
 	if err != nil {
-		return nil, entity.ErrTicketGetFailed.WithCause(err)
+		tracing.Fail(span, err)
+		return nil, err
 	}
 
 	if len(deletedTicketIDs) > 0 {
-		if err := u.ticketService.DeleteIndexTickets(ctx, deletedTicketIDs); err != nil {
-			return nil, entity.ErrIndexDeleteFailed.WithCause(err)
+		if err := u.ticketService.DeleteExpiredTickets(ctx, deletedTicketIDs); err != nil {
+			tracing.Fail(span, err)
+			return nil, err
 		}
 	}
 
+	span.SetAttributes(tracing.TicketIDs(tickets.IDs()))
+
 	return tickets, nil
 }
 
-func (u *matchUsecase) makeMatches(ctx context.Context, activeTickets entity.Tickets) (entity.Matches, *errs.Error) {
-	u.mutex.RLock()
-	mmfs := u.matchFunctions
-	u.mutex.RUnlock()
+func (u *matchUsecase) makeMatches(
+	ctx context.Context,
+	mmfs map[*entity.MatchProfile]entity.MatchFunction,
+	activeTickets entity.Tickets,
+	backfills entity.Backfills,
+) (entity.Matches, *errs.Error) {
+	ctx, span := tracing.Start(ctx, "matchUsecase.makeMatches")
+	defer span.End()
 
 	resCh := make(chan entity.Matches, len(mmfs))
 	eg, ctx := errgroup.WithContext(ctx)
@@ -124,10 +330,17 @@ func (u *matchUsecase) makeMatches(ctx context.Context, activeTickets entity.Tic
 				return err
 			}
 
-			matches, err := mmf.MakeMatches(ctx, profile, poolTickets)
+			ctx, span := tracing.Start(ctx, "matchUsecase.matchFunction", tracing.Profile(profile.Name))
+			defer span.End()
+
+			start := time.Now()
+			matches, err := mmf.MakeMatches(ctx, profile, poolTickets, filterBackfills(profile, backfills))
+			metrics.MatchFunctionDuration.WithLabelValues(profile.Name).Observe(metrics.Since(start))
 			if err != nil {
+				tracing.Fail(span, err)
 				return err
 			}
+			span.SetAttributes(tracing.MatchIDs(matches.IDs()))
 
 			resCh <- matches
 
@@ -136,7 +349,8 @@ func (u *matchUsecase) makeMatches(ctx context.Context, activeTickets entity.Tic
 	}
 
 	if err := eg.Wait(); err != nil {
-		return nil, entity.ErrMatchExecutionFailed.WithCause(err)
+		tracing.Fail(span, err)
+		return nil, entity.WithCause(entity.ErrMatchExecutionFailed, err)
 	}
 
 	close(resCh)
@@ -167,39 +381,133 @@ func filterTickets(profile *entity.MatchProfile, tickets entity.Tickets) (map[st
 	return poolTickets, nil
 }
 
+func filterBackfills(profile *entity.MatchProfile, backfills entity.Backfills) map[string]entity.Backfills {
+	poolBackfills := map[string]entity.Backfills{}
+
+	for _, pool := range profile.Pools {
+		for _, backfill := range backfills {
+			if pool.BackfillIn(backfill) {
+				poolBackfills[pool.Name] = append(poolBackfills[pool.Name], backfill)
+			}
+		}
+	}
+
+	return poolBackfills
+}
+
 func (u *matchUsecase) evaluateMatches(ctx context.Context, matches entity.Matches) (entity.Matches, *errs.Error) {
 	if u.evaluator == nil {
 		return matches, nil
 	}
 
+	ctx, span := tracing.Start(ctx, "matchUsecase.evaluate")
+	defer span.End()
+
+	start := time.Now()
 	evaluatedMatchIDs, err := u.evaluator.Evaluate(ctx, matches)
+	metrics.EvaluatorDuration.Observe(metrics.Since(start))
 	if err != nil {
-		return nil, entity.ErrMatchEvaluationFailed.WithCause(err)
+		tracing.Fail(span, err)
+		return nil, entity.WithCause(entity.ErrMatchEvaluationFailed, err)
 	}
+	span.SetAttributes(tracing.MatchIDs(evaluatedMatchIDs))
 
 	evaluatedMatches, _ := matches.SplitByIDs(evaluatedMatchIDs)
 	return evaluatedMatches, nil
 }
 
+// applyBackfills stores the backfills of the matches with the matched tickets.
+// A new backfill is created for a match with a backfill without ID if createBackfills is set, and the match is dropped otherwise.
+// A match for an existing backfill is dropped when the backfill has been changed or deleted since it was fetched.
+// The tickets of the dropped matches are released as unmatched.
+func (u *matchUsecase) applyBackfills(ctx context.Context, matches entity.Matches, backfills entity.Backfills, createBackfills bool) (entity.Matches, *errs.Error) {
+	fetched := lo.KeyBy(backfills, func(backfill *entity.Backfill) string {
+		return backfill.ID
+	})
+
+	applied := make(entity.Matches, 0, len(matches))
+
+	for _, match := range matches {
+		if match.Backfill == nil {
+			applied = append(applied, match)
+			continue
+		}
+
+		backfill := *match.Backfill
+
+		if backfill.ID == "" {
+			if !createBackfills {
+				u.logger.WarnContext(ctx, "dropped match with a new backfill, which is only created through FetchMatches",
+					logging.MatchID(match.MatchID),
+					logging.Profile(match.MatchProfile),
+				)
+				continue
+			}
+
+			backfill.ID = xid.New().String()
+			backfill.CreateTime = time.Now()
+			backfill.Generation = 1
+			backfill.TicketIDs = match.Tickets.IDs()
+
+			if err := u.backfillRepository.Insert(ctx, &backfill, u.backfillTTL); err != nil {
+				return nil, err
+			}
+		} else {
+			stored, ok := fetched[backfill.ID]
+			if !ok || stored.Generation != backfill.Generation {
+				continue
+			}
+
+			backfill.TicketIDs = append(slices.Clone(stored.TicketIDs), match.Tickets.IDs()...)
+			backfill.Generation = stored.Generation + 1
+
+			if err := u.backfillRepository.Update(ctx, &backfill, stored.Generation, u.backfillTTL); err != nil {
+				if errors.Is(err, entity.ErrBackfillGenerationMismatch) || errors.Is(err, entity.ErrBackfillNotFound) {
+					continue
+				}
+				return nil, err
+			}
+		}
+
+		match.Backfill = &backfill
+		applied = append(applied, match)
+	}
+
+	return applied, nil
+}
+
 func (u *matchUsecase) assign(ctx context.Context, matches entity.Matches) *errs.Error {
+	ctx, span := tracing.Start(ctx, "matchUsecase.assign",
+		tracing.MatchIDs(matches.IDs()),
+		tracing.TicketIDs(matches.TicketIDs()),
+	)
+	defer span.End()
+
 	var ticketIDsToRelease []string
 	defer func() {
 		if len(ticketIDsToRelease) > 0 {
-			_ = u.pendingTicketRepository.ReleaseTickets(ctx, ticketIDsToRelease)
+			u.logger.WarnContext(ctx, "released tickets that were not assigned", logging.TicketIDs(ticketIDsToRelease))
+			if err := u.ticketService.ReleaseTickets(ctx, ticketIDsToRelease); err != nil {
+				u.logger.ErrorContext(ctx, "failed to release tickets", logging.TicketIDs(ticketIDsToRelease), logging.Error(err))
+			}
 		}
 	}()
 
+	start := time.Now()
 	asgs, err := u.assigner.Assign(ctx, matches)
+	metrics.AssignerDuration.Observe(metrics.Since(start))
 	if err != nil {
+		tracing.Fail(span, err)
 		ticketIDsToRelease = append(ticketIDsToRelease, matches.TicketIDs()...)
-		return entity.ErrMatchAssignFailed.WithCause(err)
+		return entity.WithCause(entity.ErrMatchAssignFailed, err)
 	}
 
 	if len(asgs) > 0 {
 		notAssigned, err := u.assignerService.AssignTickets(ctx, asgs)
 		ticketIDsToRelease = append(ticketIDsToRelease, notAssigned...)
 		if err != nil {
-			return entity.ErrMatchAssignFailed.WithCause(err)
+			tracing.Fail(span, err)
+			return entity.WithCause(entity.ErrMatchAssignFailed, err)
 		}
 	}
 
@@ -1,21 +1,69 @@
 package usecase
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"time"
 
+	"github.com/HMasataka/collision/config"
 	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/errs"
 )
 
+// MatchFunctionRegistry is the match functions that the match profiles can refer to by name.
+type MatchFunctionRegistry map[string]entity.MatchFunction
+
+// NewMatchFunctionRegistry returns the built-in match functions and the remote match functions in the config.
+func NewMatchFunctionRegistry(cfg *config.Config) (MatchFunctionRegistry, *errs.Error) {
+	registry := MatchFunctionRegistry(MatchFunctions())
+
+	for _, f := range cfg.Match.Functions {
+		if _, ok := registry[f.Name]; ok {
+			return nil, entity.WithCause(entity.ErrConfigInvalid, fmt.Errorf("match function %q is already registered", f.Name))
+		}
+
+		conn, err := dialRemote(f.Address)
+		if err != nil {
+			return nil, entity.WithCause(entity.ErrConfigInvalid, fmt.Errorf("match function %q: %w", f.Name, err))
+		}
+
+		registry[f.Name] = NewRemoteMatchFunction(f.Name, conn, f.Timeout, *f.MaxRetries)
+	}
+
+	return registry, nil
+}
+
+// MatchFunctions returns the built-in match functions.
+func MatchFunctions() map[string]entity.MatchFunction {
+	return map[string]entity.MatchFunction{
+		"simple-1vs1": NewSimple1vs1MatchFunction(),
+		"team":        NewTeamMatchFunction(),
+		"skill":       NewSkillMatchFunction(),
+	}
+}
+
+// NewSimple1vs1MatchFunction matches two tickets of the same party size in each pool.
 func NewSimple1vs1MatchFunction() entity.MatchFunction {
-	return entity.MatchFunctionFunc(func(ctx context.Context, profile *entity.MatchProfile, poolTickets map[string]entity.Tickets) (entity.Matches, error) {
+	return entity.MatchFunctionFunc(func(ctx context.Context, profile *entity.MatchProfile, poolTickets map[string]entity.Tickets, poolBackfills map[string]entity.Backfills) (entity.Matches, error) {
 		var matches entity.Matches
 
 		for _, tickets := range poolTickets {
-			for len(tickets) >= 2 {
-				match := newMatch(profile, tickets[:2])
+			waiting := map[int]*entity.Ticket{}
+
+			for _, ticket := range tickets {
+				opponent, ok := waiting[ticket.PartySize()]
+				if !ok {
+					waiting[ticket.PartySize()] = ticket
+					continue
+				}
+				delete(waiting, ticket.PartySize())
+
+				match := newMatch(profile, "Simple1vs1", entity.Tickets{opponent, ticket})
 				match.AllocateGameserver = true
-				tickets = tickets[2:]
 				matches = append(matches, match)
 			}
 		}
@@ -24,11 +72,301 @@ func NewSimple1vs1MatchFunction() entity.MatchFunction {
 	})
 }
 
-func newMatch(profile *entity.MatchProfile, tickets entity.Tickets) *entity.Match {
+// NewTeamMatchFunction makes N-vs-N matches with the team count and the team size in the profile extensions.
+// The oldest tickets in each pool are matched first, and the team composition is written to the match extensions.
+// The backfills in the pool with open slots in their extensions are filled before new matches are made.
+func NewTeamMatchFunction() entity.MatchFunction {
+	return entity.MatchFunctionFunc(func(ctx context.Context, profile *entity.MatchProfile, poolTickets map[string]entity.Tickets, poolBackfills map[string]entity.Backfills) (entity.Matches, error) {
+		ext, err := entity.ParseTeamMatchProfileExtensions(profile.Extensions)
+		if err != nil {
+			return nil, err
+		}
+
+		var matches entity.Matches
+
+		for pool, tickets := range poolTickets {
+			tickets = slices.Clone(tickets)
+			slices.SortStableFunc(tickets, func(a, b *entity.Ticket) int {
+				return a.CreatedAt.Compare(b.CreatedAt)
+			})
+
+			backfills := slices.Clone(poolBackfills[pool])
+			slices.SortStableFunc(backfills, func(a, b *entity.Backfill) int {
+				return a.CreateTime.Compare(b.CreateTime)
+			})
+
+			for _, backfill := range backfills {
+				match, rest, err := fillBackfill(profile, backfill, tickets)
+				if err != nil {
+					return nil, err
+				}
+				if match != nil {
+					matches = append(matches, match)
+					tickets = rest
+				}
+			}
+
+			for {
+				teams, rest, ok := fillTeams(ext, tickets)
+				if !ok {
+					break
+				}
+				tickets = rest
+
+				match, err := newTeamMatch(profile, teams)
+				if err != nil {
+					return nil, err
+				}
+				matches = append(matches, match)
+			}
+		}
+
+		return matches, nil
+	})
+}
+
+// fillTeams puts the tickets in order into the first team with room for the whole party.
+// It returns the filled teams and the tickets left, or false if the tickets cannot fill all the teams.
+func fillTeams(ext *entity.TeamMatchProfileExtensions, tickets entity.Tickets) ([]entity.Tickets, entity.Tickets, bool) {
+	teams := make([]entity.Tickets, ext.TeamCount)
+	var rest entity.Tickets
+	filled := 0
+
+	for i, ticket := range tickets {
+		if filled == ext.TeamCount {
+			rest = append(rest, tickets[i:]...)
+			break
+		}
+
+		j := slices.IndexFunc(teams, func(team entity.Tickets) bool {
+			return team.PartySize()+ticket.PartySize() <= ext.TeamSize
+		})
+		if j < 0 {
+			rest = append(rest, ticket)
+			continue
+		}
+
+		teams[j] = append(teams[j], ticket)
+		if teams[j].PartySize() == ext.TeamSize {
+			filled++
+		}
+	}
+
+	return teams, rest, filled == ext.TeamCount
+}
+
+// fillBackfill puts the tickets in order into the first team of the backfill with room for the whole party.
+// It returns nil if the backfill has no open slots or no ticket fits in.
+func fillBackfill(profile *entity.MatchProfile, backfill *entity.Backfill, tickets entity.Tickets) (*entity.Match, entity.Tickets, error) {
+	var ext entity.TeamBackfillExtensions
+	if err := json.Unmarshal(backfill.Extensions, &ext); err != nil || len(ext.OpenSlots) == 0 {
+		return nil, tickets, nil
+	}
+
+	names := slices.Sorted(maps.Keys(ext.OpenSlots))
+	added := map[string]entity.Tickets{}
+	var rest entity.Tickets
+
+	for _, ticket := range tickets {
+		i := slices.IndexFunc(names, func(name string) bool {
+			return ext.OpenSlots[name] >= ticket.PartySize()
+		})
+		if i < 0 {
+			rest = append(rest, ticket)
+			continue
+		}
+
+		ext.OpenSlots[names[i]] -= ticket.PartySize()
+		added[names[i]] = append(added[names[i]], ticket)
+	}
+
+	if len(added) == 0 {
+		return nil, tickets, nil
+	}
+
+	extensions, err := withOpenSlots(backfill.Extensions, ext.OpenSlots)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var matched entity.Tickets
+	var teams []*entity.Team
+	for _, name := range names {
+		if len(added[name]) == 0 {
+			continue
+		}
+
+		matched = append(matched, added[name]...)
+		teams = append(teams, &entity.Team{Name: name, TicketIDs: added[name].IDs()})
+	}
+
+	matchExtensions, err := json.Marshal(&entity.TeamMatchExtensions{Teams: teams})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filled := *backfill
+	filled.Extensions = extensions
+
+	match := newMatch(profile, "Team", matched)
+	match.MatchID = fmt.Sprintf("%s_%s_%v", profile.Name, backfill.ID, matched.IDs())
+	match.Backfill = &filled
+	match.Extensions = matchExtensions
+
+	return match, rest, nil
+}
+
+// withOpenSlots replaces the open slots in the backfill extensions and keeps the other keys.
+func withOpenSlots(extensions []byte, openSlots map[string]int) ([]byte, error) {
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(extensions, &m); err != nil {
+		return nil, err
+	}
+
+	slots, err := json.Marshal(openSlots)
+	if err != nil {
+		return nil, err
+	}
+	m["open_slots"] = slots
+
+	return json.Marshal(m)
+}
+
+func newTeamMatch(profile *entity.MatchProfile, teams []entity.Tickets) (*entity.Match, error) {
+	var tickets entity.Tickets
+	teamExtensions := make([]*entity.Team, len(teams))
+	for i, team := range teams {
+		tickets = append(tickets, team...)
+
+		teamExtensions[i] = &entity.Team{
+			Name:      fmt.Sprintf("team%d", i+1),
+			TicketIDs: team.IDs(),
+		}
+	}
+
+	extensions, err := json.Marshal(&entity.TeamMatchExtensions{Teams: teamExtensions})
+	if err != nil {
+		return nil, err
+	}
+
+	match := newMatch(profile, "Team", tickets)
+	match.Extensions = extensions
+	match.AllocateGameserver = true
+
+	return match, nil
+}
+
+// NewSkillMatchFunction makes matches of tickets with close ratings.
+// The tickets in each pool are sorted by the rating, and consecutive tickets are matched
+// when the rating spread is within the allowed spread, which widens as the oldest of them waits longer.
+// Tickets without the rating are not matched.
+func NewSkillMatchFunction() entity.MatchFunction {
+	return entity.MatchFunctionFunc(func(ctx context.Context, profile *entity.MatchProfile, poolTickets map[string]entity.Tickets, poolBackfills map[string]entity.Backfills) (entity.Matches, error) {
+		ext, err := entity.ParseSkillMatchProfileExtensions(profile.Extensions)
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+
+		var matches entity.Matches
+
+		for _, tickets := range poolTickets {
+			rated := make([]ratedTicket, 0, len(tickets))
+			for _, ticket := range tickets {
+				if ticket.SearchFields == nil {
+					continue
+				}
+				if rating, ok := ticket.SearchFields.DoubleArgs[ext.RatingArg]; ok {
+					rated = append(rated, ratedTicket{ticket: ticket, rating: rating})
+				}
+			}
+
+			slices.SortStableFunc(rated, func(a, b ratedTicket) int {
+				return cmp.Compare(a.rating, b.rating)
+			})
+
+			for i := 0; i < len(rated); {
+				// Parties are kept whole, so the group is the consecutive tickets that have exactly MatchSize players.
+				group, ok := skillGroup(rated[i:], ext.MatchSize)
+				if !ok {
+					i++
+					continue
+				}
+
+				spread := group[len(group)-1].rating - group[0].rating
+				oldest := slices.MinFunc(group, func(a, b ratedTicket) int {
+					return a.ticket.CreatedAt.Compare(b.ticket.CreatedAt)
+				})
+				if spread > ext.AllowedSpread(now.Sub(oldest.ticket.CreatedAt)) {
+					i++
+					continue
+				}
+
+				match, err := newSkillMatch(profile, group, spread, now)
+				if err != nil {
+					return nil, err
+				}
+				matches = append(matches, match)
+
+				i += len(group)
+			}
+		}
+
+		return matches, nil
+	})
+}
+
+type ratedTicket struct {
+	ticket *entity.Ticket
+	rating float64
+}
+
+func skillGroup(rated []ratedTicket, matchSize int) ([]ratedTicket, bool) {
+	size := 0
+	for i, rt := range rated {
+		size += rt.ticket.PartySize()
+		if size >= matchSize {
+			return rated[:i+1], size == matchSize
+		}
+	}
+
+	return nil, false
+}
+
+func newSkillMatch(profile *entity.MatchProfile, group []ratedTicket, spread float64, now time.Time) (*entity.Match, error) {
+	tickets := make(entity.Tickets, len(group))
+	var totalRating, totalWait float64
+	for i, rt := range group {
+		tickets[i] = rt.ticket
+		// The averages are weighted by the party size, as the rating of a party stands for all its members.
+		totalRating += rt.rating * float64(rt.ticket.PartySize())
+		totalWait += max(now.Sub(rt.ticket.CreatedAt).Seconds(), 0) * float64(rt.ticket.PartySize())
+	}
+	players := float64(tickets.PartySize())
+
+	extensions, err := json.Marshal(&entity.SkillMatchExtensions{
+		Score:              -spread,
+		RatingSpread:       spread,
+		AverageRating:      totalRating / players,
+		AverageWaitSeconds: totalWait / players,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	match := newMatch(profile, "Skill", tickets)
+	match.Extensions = extensions
+	match.AllocateGameserver = true
+
+	return match, nil
+}
+
+func newMatch(profile *entity.MatchProfile, matchFunction string, tickets entity.Tickets) *entity.Match {
 	return &entity.Match{
 		MatchID:       fmt.Sprintf("%s_%v", profile.Name, tickets.IDs()),
 		MatchProfile:  profile.Name,
-		MatchFunction: "Simple1vs1",
+		MatchFunction: matchFunction,
 		Tickets:       tickets,
 	}
 }
@@ -0,0 +1,396 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+)
+
+// newTestTicket returns a ticket created at the time, with the members for a party.
+func newTestTicket(id string, createdAt time.Time, members ...string) *entity.Ticket {
+	return &entity.Ticket{ID: id, SearchFields: &entity.SearchFields{}, CreatedAt: createdAt, Members: members}
+}
+
+// leftTicketIDs returns the IDs of the tickets left out of the matches, which stay in the pool for the next tick.
+func leftTicketIDs(matches entity.Matches, tickets entity.Tickets) []string {
+	var matched []string
+	for _, match := range matches {
+		matched = append(matched, match.Tickets.IDs()...)
+	}
+
+	var rest []string
+	for _, ticket := range tickets {
+		if !slices.Contains(matched, ticket.ID) {
+			rest = append(rest, ticket.ID)
+		}
+	}
+
+	return rest
+}
+
+func TestTeamMatchFunction(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(i int) time.Time { return base.Add(time.Duration(i) * time.Second) }
+
+	type wantMatch struct {
+		teams     map[string][]string
+		openSlots map[string]int
+	}
+
+	tests := []struct {
+		name      string
+		teamSize  int
+		tickets   entity.Tickets
+		backfills entity.Backfills
+		want      []wantMatch
+		wantRest  []string
+	}{
+		{
+			name:     "oldest tickets first and the rest left in the pool",
+			teamSize: 2,
+			tickets: entity.Tickets{
+				newTestTicket("t5", at(5)),
+				newTestTicket("t1", at(1)),
+				newTestTicket("t2", at(2)),
+				newTestTicket("t3", at(3)),
+				newTestTicket("t4", at(4)),
+			},
+			want: []wantMatch{
+				{teams: map[string][]string{"team1": {"t1", "t2"}, "team2": {"t3", "t4"}}},
+			},
+			wantRest: []string{"t5"},
+		},
+		{
+			name:     "parties are not split",
+			teamSize: 3,
+			tickets: entity.Tickets{
+				newTestTicket("p1", at(1), "a", "b"),
+				newTestTicket("p2", at(2), "c", "d"),
+				newTestTicket("s1", at(3)),
+				newTestTicket("s2", at(4)),
+			},
+			want: []wantMatch{
+				{teams: map[string][]string{"team1": {"p1", "s1"}, "team2": {"p2", "s2"}}},
+			},
+		},
+		{
+			name:     "uneven party sizes cannot fill the teams",
+			teamSize: 3,
+			tickets: entity.Tickets{
+				newTestTicket("p1", at(1), "a", "b"),
+				newTestTicket("p2", at(2), "c", "d"),
+				newTestTicket("p3", at(3), "e", "f"),
+				newTestTicket("s1", at(4)),
+			},
+			wantRest: []string{"p1", "p2", "p3", "s1"},
+		},
+		{
+			name:     "party larger than a team",
+			teamSize: 2,
+			tickets: entity.Tickets{
+				newTestTicket("p1", at(1), "a", "b", "c"),
+				newTestTicket("s1", at(2)),
+				newTestTicket("s2", at(3)),
+				newTestTicket("s3", at(4)),
+				newTestTicket("s4", at(5)),
+			},
+			want: []wantMatch{
+				{teams: map[string][]string{"team1": {"s1", "s2"}, "team2": {"s3", "s4"}}},
+			},
+			wantRest: []string{"p1"},
+		},
+		{
+			name:     "backfill with partial open slots",
+			teamSize: 3,
+			tickets: entity.Tickets{
+				newTestTicket("p1", at(1), "a", "b"),
+				newTestTicket("s1", at(2)),
+				newTestTicket("s2", at(3)),
+			},
+			backfills: entity.Backfills{
+				{ID: "b1", CreateTime: at(0), Extensions: []byte(`{"map":"forest","open_slots":{"team1":1,"team2":2}}`)},
+			},
+			want: []wantMatch{
+				{
+					teams:     map[string][]string{"team1": {"s1"}, "team2": {"p1"}},
+					openSlots: map[string]int{"team1": 0, "team2": 0},
+				},
+			},
+			wantRest: []string{"s2"},
+		},
+		{
+			name:     "backfill without open slots",
+			teamSize: 1,
+			tickets: entity.Tickets{
+				newTestTicket("s1", at(1)),
+				newTestTicket("s2", at(2)),
+			},
+			backfills: entity.Backfills{
+				{ID: "b1", CreateTime: at(0), Extensions: []byte(`{"open_slots":{"team1":0}}`)},
+			},
+			want: []wantMatch{
+				{teams: map[string][]string{"team1": {"s1"}, "team2": {"s2"}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extensions, err := json.Marshal(&entity.TeamMatchProfileExtensions{TeamCount: 2, TeamSize: tt.teamSize})
+			if err != nil {
+				t.Fatal(err)
+			}
+			profile := &entity.MatchProfile{Name: "team", Extensions: extensions}
+
+			matches, err := NewTeamMatchFunction().MakeMatches(context.Background(), profile,
+				map[string]entity.Tickets{"all": tt.tickets},
+				map[string]entity.Backfills{"all": tt.backfills},
+			)
+			if err != nil {
+				t.Fatalf("MakeMatches() error = %v", err)
+			}
+
+			if len(matches) != len(tt.want) {
+				t.Fatalf("MakeMatches() = %d matches, want %d", len(matches), len(tt.want))
+			}
+			for i, match := range matches {
+				var ext entity.TeamMatchExtensions
+				if err := json.Unmarshal(match.Extensions, &ext); err != nil {
+					t.Fatalf("match %d extensions error = %v", i, err)
+				}
+				teams := map[string][]string{}
+				for _, team := range ext.Teams {
+					teams[team.Name] = team.TicketIDs
+				}
+				if !maps.EqualFunc(teams, tt.want[i].teams, slices.Equal) {
+					t.Errorf("match %d teams = %v, want %v", i, teams, tt.want[i].teams)
+				}
+
+				if tt.want[i].openSlots == nil {
+					if match.Backfill != nil {
+						t.Errorf("match %d backfill = %v, want nil", i, match.Backfill.ID)
+					}
+					continue
+				}
+				if match.Backfill == nil {
+					t.Fatalf("match %d has no backfill", i)
+				}
+				var backfillExt struct {
+					Map       string         `json:"map"`
+					OpenSlots map[string]int `json:"open_slots"`
+				}
+				if err := json.Unmarshal(match.Backfill.Extensions, &backfillExt); err != nil {
+					t.Fatalf("match %d backfill extensions error = %v", i, err)
+				}
+				if !maps.Equal(backfillExt.OpenSlots, tt.want[i].openSlots) {
+					t.Errorf("match %d open slots = %v, want %v", i, backfillExt.OpenSlots, tt.want[i].openSlots)
+				}
+				// The other keys of the backfill extensions are kept.
+				if backfillExt.Map != "forest" {
+					t.Errorf("match %d backfill extensions = %s, want the map kept", i, match.Backfill.Extensions)
+				}
+			}
+
+			if rest := leftTicketIDs(matches, tt.tickets); !slices.Equal(rest, tt.wantRest) {
+				t.Errorf("tickets left = %v, want %v", rest, tt.wantRest)
+			}
+		})
+	}
+}
+
+func TestSkillMatchFunction(t *testing.T) {
+	now := time.Now()
+	rated := func(id string, rating float64, wait time.Duration) *entity.Ticket {
+		ticket := newTestTicket(id, now.Add(-wait))
+		ticket.SearchFields.DoubleArgs = map[string]float64{"mmr": rating}
+		return ticket
+	}
+
+	tests := []struct {
+		name     string
+		ext      entity.SkillMatchProfileExtensions
+		tickets  entity.Tickets
+		want     [][]string
+		wantRest []string
+	}{
+		{
+			name: "spread at the boundary",
+			ext:  entity.SkillMatchProfileExtensions{MaxSpread: 100},
+			tickets: entity.Tickets{
+				rated("t1", 1000, 0),
+				rated("t2", 1100, 0),
+			},
+			want: [][]string{{"t1", "t2"}},
+		},
+		{
+			name: "spread over the boundary",
+			ext:  entity.SkillMatchProfileExtensions{MaxSpread: 100},
+			tickets: entity.Tickets{
+				rated("t1", 1000, 0),
+				rated("t2", 1100.5, 0),
+			},
+			wantRest: []string{"t1", "t2"},
+		},
+		{
+			name: "consecutive ratings in order",
+			ext:  entity.SkillMatchProfileExtensions{MaxSpread: 100},
+			tickets: entity.Tickets{
+				rated("t1", 1000, 0),
+				rated("t2", 1090, 0),
+				rated("t3", 1150, 0),
+				rated("t4", 1200, 0),
+			},
+			want: [][]string{{"t1", "t2"}, {"t3", "t4"}},
+		},
+		{
+			name: "missing rating",
+			ext:  entity.SkillMatchProfileExtensions{MaxSpread: 100},
+			tickets: entity.Tickets{
+				{ID: "no-search-fields", CreatedAt: now},
+				newTestTicket("no-rating", now),
+				rated("t1", 1000, 0),
+				rated("t2", 1050, 0),
+			},
+			want:     [][]string{{"t1", "t2"}},
+			wantRest: []string{"no-search-fields", "no-rating"},
+		},
+		{
+			name: "spread widened by the wait",
+			ext:  entity.SkillMatchProfileExtensions{MaxSpread: 100, SpreadPerSecond: 10},
+			tickets: entity.Tickets{
+				rated("t1", 1000, 20*time.Second),
+				rated("t2", 1250, 0),
+			},
+			want: [][]string{{"t1", "t2"}},
+		},
+		{
+			name: "spread not widened enough yet",
+			ext:  entity.SkillMatchProfileExtensions{MaxSpread: 100, SpreadPerSecond: 10},
+			tickets: entity.Tickets{
+				rated("t1", 1000, 5*time.Second),
+				rated("t2", 1250, 0),
+			},
+			wantRest: []string{"t1", "t2"},
+		},
+		{
+			name: "widened spread capped by the limit",
+			ext:  entity.SkillMatchProfileExtensions{MaxSpread: 100, SpreadPerSecond: 10, MaxSpreadLimit: 200},
+			tickets: entity.Tickets{
+				rated("t1", 1000, time.Minute),
+				rated("t2", 1250, 0),
+			},
+			wantRest: []string{"t1", "t2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.ext.RatingArg = "mmr"
+			tt.ext.MatchSize = 2
+			extensions, err := json.Marshal(&tt.ext)
+			if err != nil {
+				t.Fatal(err)
+			}
+			profile := &entity.MatchProfile{Name: "skill", Extensions: extensions}
+
+			matches, err := NewSkillMatchFunction().MakeMatches(context.Background(), profile,
+				map[string]entity.Tickets{"all": tt.tickets}, nil)
+			if err != nil {
+				t.Fatalf("MakeMatches() error = %v", err)
+			}
+
+			got := make([][]string, 0, len(matches))
+			for _, match := range matches {
+				got = append(got, match.Tickets.IDs())
+			}
+			if !slices.EqualFunc(got, tt.want, slices.Equal) {
+				t.Errorf("MakeMatches() = %v, want %v", got, tt.want)
+			}
+
+			if rest := leftTicketIDs(matches, tt.tickets); !slices.Equal(rest, tt.wantRest) {
+				t.Errorf("tickets left = %v, want %v", rest, tt.wantRest)
+			}
+		})
+	}
+}
+
+func TestSimple1vs1MatchFunction(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		tickets  entity.Tickets
+		want     [][]string
+		wantRest []string
+	}{
+		{
+			name: "odd number of players",
+			tickets: entity.Tickets{
+				newTestTicket("s1", base),
+				newTestTicket("s2", base),
+				newTestTicket("s3", base),
+			},
+			want:     [][]string{{"s1", "s2"}},
+			wantRest: []string{"s3"},
+		},
+		{
+			name: "odd number of parties",
+			tickets: entity.Tickets{
+				newTestTicket("p1", base, "a", "b"),
+				newTestTicket("p2", base, "c", "d"),
+				newTestTicket("p3", base, "e", "f"),
+			},
+			want:     [][]string{{"p1", "p2"}},
+			wantRest: []string{"p3"},
+		},
+		{
+			name: "parties paired by the size",
+			tickets: entity.Tickets{
+				newTestTicket("p1", base, "a", "b"),
+				newTestTicket("s1", base),
+				newTestTicket("p2", base, "c", "d"),
+				newTestTicket("s2", base),
+			},
+			want: [][]string{{"p1", "p2"}, {"s1", "s2"}},
+		},
+		{
+			name: "larger party without an opponent of the size",
+			tickets: entity.Tickets{
+				newTestTicket("p1", base, "a", "b", "c", "d", "e"),
+				newTestTicket("p2", base, "f", "g"),
+				newTestTicket("p3", base, "h", "i"),
+			},
+			want:     [][]string{{"p2", "p3"}},
+			wantRest: []string{"p1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &entity.MatchProfile{Name: "simple"}
+
+			matches, err := NewSimple1vs1MatchFunction().MakeMatches(context.Background(), profile,
+				map[string]entity.Tickets{"all": tt.tickets}, nil)
+			if err != nil {
+				t.Fatalf("MakeMatches() error = %v", err)
+			}
+
+			got := make([][]string, 0, len(matches))
+			for _, match := range matches {
+				got = append(got, match.Tickets.IDs())
+			}
+			if !slices.EqualFunc(got, tt.want, slices.Equal) {
+				t.Errorf("MakeMatches() = %v, want %v", got, tt.want)
+			}
+
+			if rest := leftTicketIDs(matches, tt.tickets); !slices.Equal(rest, tt.wantRest) {
+				t.Errorf("tickets left = %v, want %v", rest, tt.wantRest)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/HMasataka/collision/config"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/errs"
+)
+
+// ProfileUsecase changes the match profiles of the match loop while the server is running.
+// The profiles are bound to the match functions registered at startup, which cannot be changed at runtime.
+// While the profiles files are watched, the files are the only source of the profiles,
+// so the profiles cannot be put or deleted as the changes would be lost on the next reload.
+type ProfileUsecase interface {
+	ListMatchProfiles(ctx context.Context) []*entity.MatchProfile
+	PutMatchProfile(ctx context.Context, profile *entity.MatchProfile, matchFunction string) *errs.Error
+	DeleteMatchProfile(ctx context.Context, name string) *errs.Error
+	ReloadMatchProfiles(ctx context.Context) *errs.Error
+}
+
+type profileUsecase struct {
+	cfg            *config.Config
+	matchFunctions map[string]entity.MatchFunction
+	matchUsecase   MatchUsecase
+}
+
+func NewProfileUsecase(
+	cfg *config.Config,
+	matchFunctions map[string]entity.MatchFunction,
+	matchUsecase MatchUsecase,
+) ProfileUsecase {
+	return &profileUsecase{
+		cfg:            cfg,
+		matchFunctions: matchFunctions,
+		matchUsecase:   matchUsecase,
+	}
+}
+
+func (u *profileUsecase) ListMatchProfiles(ctx context.Context) []*entity.MatchProfile {
+	return u.matchUsecase.MatchProfiles(ctx)
+}
+
+func (u *profileUsecase) PutMatchProfile(ctx context.Context, profile *entity.MatchProfile, matchFunction string) *errs.Error {
+	if u.cfg.Match.WatchesProfiles() {
+		return entity.ErrMatchProfilesWatched
+	}
+
+	mmf, err := config.BindMatchProfile(profile, matchFunction, u.matchFunctions)
+	if err != nil {
+		return err
+	}
+	profile.MatchFunction = matchFunction
+
+	u.matchUsecase.PutMatchProfile(ctx, profile, mmf)
+
+	return nil
+}
+
+func (u *profileUsecase) DeleteMatchProfile(ctx context.Context, name string) *errs.Error {
+	if u.cfg.Match.WatchesProfiles() {
+		return entity.ErrMatchProfilesWatched
+	}
+
+	return u.matchUsecase.DeleteMatchProfile(ctx, name)
+}
+
+// ReloadMatchProfiles reads the profiles files again and replaces all the profiles,
+// including the ones put or deleted with PutMatchProfile and DeleteMatchProfile.
+// Each profile is bound again to the registered match function of its name.
+// The current profiles are kept if the files are invalid.
+func (u *profileUsecase) ReloadMatchProfiles(ctx context.Context) *errs.Error {
+	profiles, err := u.cfg.MatchProfiles()
+	if err != nil {
+		return err
+	}
+
+	mmfs, err := config.BindMatchProfiles(profiles, u.matchFunctions)
+	if err != nil {
+		return err
+	}
+
+	u.matchUsecase.ReplaceMatchProfiles(ctx, mmfs)
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/HMasataka/collision/config"
+	"github.com/HMasataka/collision/domain/entity"
+)
+
+// namedMatchFunction is a match function that can be told apart from the others by the pointer.
+type namedMatchFunction struct {
+	name string
+}
+
+func (f *namedMatchFunction) MakeMatches(context.Context, *entity.MatchProfile, map[string]entity.Tickets, map[string]entity.Backfills) (entity.Matches, error) {
+	return nil, nil
+}
+
+// newTestProfileUsecase returns the profile use case reading the profiles file written with the content.
+func newTestProfileUsecase(t *testing.T, content string, watch bool, registry map[string]entity.MatchFunction) (ProfileUsecase, *testUseCases, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "profiles.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	cfg := config.Default()
+	cfg.Match.Profiles = path
+	cfg.Match.WatchProfiles = watch
+
+	profiles, err := cfg.MatchProfiles()
+	if err != nil {
+		t.Fatalf("MatchProfiles() error = %v: %v", err, err.Unwrap())
+	}
+	mmfs, err := config.BindMatchProfiles(profiles, registry)
+	if err != nil {
+		t.Fatalf("BindMatchProfiles() error = %v: %v", err, err.Unwrap())
+	}
+
+	u := newTestUseCases(t, mmfs)
+
+	return NewProfileUsecase(cfg, registry, u.MatchUsecase), u, path
+}
+
+func profileNames(profiles []*entity.MatchProfile) []string {
+	names := make([]string, 0, len(profiles))
+	for _, p := range profiles {
+		names = append(names, p.Name)
+	}
+
+	return names
+}
+
+func TestProfileUsecaseRejectsChangesWhileWatched(t *testing.T) {
+	ctx := context.Background()
+	registry := map[string]entity.MatchFunction{"first": &namedMatchFunction{name: "first"}}
+
+	u, _, _ := newTestProfileUsecase(t, "profiles:\n  - name: a\n    matchFunction: first\n    pools:\n      - name: all\n", true, registry)
+
+	profile := &entity.MatchProfile{Name: "b", Pools: []*entity.Pool{{Name: "all"}}}
+	if err := u.PutMatchProfile(ctx, profile, "first"); !errors.Is(err, entity.ErrMatchProfilesWatched) {
+		t.Errorf("PutMatchProfile() error = %v, want %v", err, entity.ErrMatchProfilesWatched)
+	}
+	if err := u.DeleteMatchProfile(ctx, "a"); !errors.Is(err, entity.ErrMatchProfilesWatched) {
+		t.Errorf("DeleteMatchProfile() error = %v, want %v", err, entity.ErrMatchProfilesWatched)
+	}
+
+	if names := profileNames(u.ListMatchProfiles(ctx)); len(names) != 1 || names[0] != "a" {
+		t.Errorf("ListMatchProfiles() = %v, want [a]", names)
+	}
+}
+
+func TestProfileUsecaseReloadAfterPut(t *testing.T) {
+	ctx := context.Background()
+	first := &namedMatchFunction{name: "first"}
+	second := &namedMatchFunction{name: "second"}
+	registry := map[string]entity.MatchFunction{"first": first, "second": second}
+
+	u, container, path := newTestProfileUsecase(t, "profiles:\n  - name: a\n    matchFunction: first\n    pools:\n      - name: all\n", false, registry)
+
+	profile := &entity.MatchProfile{Name: "b", Pools: []*entity.Pool{{Name: "all"}}}
+	if err := u.PutMatchProfile(ctx, profile, "first"); err != nil {
+		t.Fatalf("PutMatchProfile() error = %v", err)
+	}
+	if names := profileNames(u.ListMatchProfiles(ctx)); len(names) != 2 {
+		t.Fatalf("ListMatchProfiles() = %v, want [a b]", names)
+	}
+
+	if err := os.WriteFile(path, []byte("profiles:\n  - name: a\n    matchFunction: second\n    pools:\n      - name: all\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := u.ReloadMatchProfiles(ctx); err != nil {
+		t.Fatalf("ReloadMatchProfiles() error = %v: %v", err, err.Unwrap())
+	}
+
+	// The profile put through the admin service is replaced by the files.
+	profiles := u.ListMatchProfiles(ctx)
+	if names := profileNames(profiles); len(names) != 1 || names[0] != "a" {
+		t.Fatalf("ListMatchProfiles() = %v, want [a]", names)
+	}
+	if profiles[0].MatchFunction != "second" {
+		t.Errorf("MatchFunction = %q, want second", profiles[0].MatchFunction)
+	}
+
+	mmf, err := container.MatchUsecase.(*matchUsecase).findMatchFunction("a")
+	if err != nil {
+		t.Fatalf("findMatchFunction() error = %v", err)
+	}
+	if mmf != second {
+		t.Errorf("profile a is bound to %v, want %v", mmf, second)
+	}
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/sethvargo/go-retry"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const remoteRetryInterval = 100 * time.Millisecond
+
+func dialRemote(address string) (*grpc.ClientConn, error) {
+	return grpc.NewClient(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// The trace continues to the remote service.
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+}
+
+// callRemote calls the remote service with the timeout for each call,
+// and retries up to maxRetries times while the call fails with a temporary error.
+func callRemote(ctx context.Context, timeout time.Duration, maxRetries uint64, call func(ctx context.Context) error) error {
+	backoff := retry.WithMaxRetries(maxRetries, retry.NewExponential(remoteRetryInterval))
+
+	return retry.Do(ctx, backoff, func(ctx context.Context) error {
+		callCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		if err := call(callCtx); err != nil {
+			if isTemporary(err) {
+				return retry.RetryableError(err)
+			}
+			return err
+		}
+
+		return nil
+	})
+}
+
+func isTemporary(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/HMasataka/collision/conv"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/gen/pb"
+	"google.golang.org/grpc"
+)
+
+type remoteAssigner struct {
+	client     pb.AssignerServiceClient
+	timeout    time.Duration
+	maxRetries uint64
+}
+
+// NewRemoteAssigner returns the assigner that calls the AssignerService.
+// Each call has the timeout, and the calls failing with a temporary error are retried up to maxRetries times,
+// so the service should allocate the game servers idempotently unless maxRetries is zero.
+func NewRemoteAssigner(conn grpc.ClientConnInterface, timeout time.Duration, maxRetries uint64) entity.Assigner {
+	return &remoteAssigner{
+		client:     pb.NewAssignerServiceClient(conn),
+		timeout:    timeout,
+		maxRetries: maxRetries,
+	}
+}
+
+func (a *remoteAssigner) Assign(ctx context.Context, matches entity.Matches) ([]*entity.AssignmentGroup, error) {
+	req := &pb.AssignRequest{Matches: make([]*pb.Match, 0, len(matches))}
+	given := map[string]struct{}{}
+	for _, match := range matches {
+		req.Matches = append(req.Matches, conv.ToPbMatch(match))
+		for _, id := range match.Tickets.IDs() {
+			given[id] = struct{}{}
+		}
+	}
+
+	var res *pb.AssignResponse
+	if err := callRemote(ctx, a.timeout, a.maxRetries, func(ctx context.Context) error {
+		r, err := a.client.Assign(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		res = r
+		return nil
+	}); err != nil {
+		return nil, entity.WithCause(entity.ErrRemoteAssignerFailed, err)
+	}
+
+	asgs := conv.ToAssignmentGroups(res.GetAssignments())
+	assigned := map[string]struct{}{}
+	for i, asg := range asgs {
+		if asg.Assignment == nil {
+			return nil, entity.WithCause(entity.ErrRemoteAssignerInvalid, fmt.Errorf("assignments[%d] has no assignment", i))
+		}
+
+		for _, id := range asg.TicketIds {
+			if _, ok := given[id]; !ok {
+				return nil, entity.WithCause(entity.ErrRemoteAssignerInvalid, fmt.Errorf("ticket %q was not given", id))
+			}
+			if _, ok := assigned[id]; ok {
+				return nil, entity.WithCause(entity.ErrRemoteAssignerInvalid, fmt.Errorf("ticket %q is assigned more than once", id))
+			}
+			assigned[id] = struct{}{}
+		}
+	}
+
+	return asgs, nil
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/HMasataka/collision/conv"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/gen/pb"
+	"google.golang.org/grpc"
+)
+
+type remoteEvaluator struct {
+	client     pb.EvaluatorServiceClient
+	timeout    time.Duration
+	maxRetries uint64
+}
+
+// NewRemoteEvaluator returns the evaluator that calls the EvaluatorService.
+// Each call has the timeout, and the calls failing with a temporary error are retried up to maxRetries times.
+func NewRemoteEvaluator(conn grpc.ClientConnInterface, timeout time.Duration, maxRetries uint64) entity.Evaluator {
+	return &remoteEvaluator{
+		client:     pb.NewEvaluatorServiceClient(conn),
+		timeout:    timeout,
+		maxRetries: maxRetries,
+	}
+}
+
+func (e *remoteEvaluator) Evaluate(ctx context.Context, matches []*entity.Match) ([]string, error) {
+	req := &pb.EvaluateRequest{Matches: make([]*pb.Match, 0, len(matches))}
+	given := make(map[string]struct{}, len(matches))
+	for _, match := range matches {
+		req.Matches = append(req.Matches, conv.ToPbMatch(match))
+		given[match.MatchID] = struct{}{}
+	}
+
+	var res *pb.EvaluateResponse
+	if err := callRemote(ctx, e.timeout, e.maxRetries, func(ctx context.Context) error {
+		r, err := e.client.Evaluate(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		res = r
+		return nil
+	}); err != nil {
+		return nil, entity.WithCause(entity.ErrRemoteEvaluatorFailed, err)
+	}
+
+	for _, id := range res.GetMatchIds() {
+		if _, ok := given[id]; !ok {
+			return nil, entity.WithCause(entity.ErrRemoteEvaluatorInvalid, fmt.Errorf("match %q was not given", id))
+		}
+	}
+
+	return res.GetMatchIds(), nil
+}
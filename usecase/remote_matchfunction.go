@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/HMasataka/collision/conv"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/gen/pb"
+	"google.golang.org/grpc"
+)
+
+type remoteMatchFunction struct {
+	name       string
+	client     pb.MatchFunctionServiceClient
+	timeout    time.Duration
+	maxRetries uint64
+}
+
+// NewRemoteMatchFunction returns the match function that calls the MatchFunctionService.
+// Each call has the timeout, and the calls failing with a temporary error are retried up to maxRetries times.
+// The matches are checked to contain only the tickets and the backfills sent to the service.
+func NewRemoteMatchFunction(name string, conn grpc.ClientConnInterface, timeout time.Duration, maxRetries uint64) entity.MatchFunction {
+	return &remoteMatchFunction{
+		name:       name,
+		client:     pb.NewMatchFunctionServiceClient(conn),
+		timeout:    timeout,
+		maxRetries: maxRetries,
+	}
+}
+
+func (f *remoteMatchFunction) MakeMatches(ctx context.Context, profile *entity.MatchProfile, poolTickets map[string]entity.Tickets, poolBackfills map[string]entity.Backfills) (entity.Matches, error) {
+	req := &pb.RunRequest{Profile: conv.ToPbMatchProfile(profile)}
+	tickets := map[string]*entity.Ticket{}
+	backfills := map[string]*entity.Backfill{}
+
+	for _, pool := range slices.Sorted(maps.Keys(poolTickets)) {
+		pt := &pb.PoolTickets{Pool: pool}
+		for _, ticket := range poolTickets[pool] {
+			tickets[ticket.ID] = ticket
+			pt.Tickets = append(pt.Tickets, conv.ToPbTicket(ticket))
+		}
+		for _, backfill := range poolBackfills[pool] {
+			backfills[backfill.ID] = backfill
+			pt.Backfills = append(pt.Backfills, conv.ToPbBackfill(backfill))
+		}
+		req.Pools = append(req.Pools, pt)
+	}
+
+	var res []*pb.Match
+	if err := callRemote(ctx, f.timeout, f.maxRetries, func(ctx context.Context) error {
+		matches, err := f.run(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		res = matches
+		return nil
+	}); err != nil {
+		return nil, entity.WithCause(entity.ErrRemoteMatchFunctionFailed, fmt.Errorf("%s: %w", f.name, err))
+	}
+
+	matches, err := f.toMatches(profile, res, tickets, backfills)
+	if err != nil {
+		return nil, entity.WithCause(entity.ErrRemoteMatchFunctionInvalid, fmt.Errorf("%s: %w", f.name, err))
+	}
+
+	return matches, nil
+}
+
+func (f *remoteMatchFunction) run(ctx context.Context, req *pb.RunRequest) ([]*pb.Match, error) {
+	stream, err := f.client.Run(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*pb.Match
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return matches, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		matches = append(matches, res.GetMatch())
+	}
+}
+
+// toMatches replaces the tickets in the matches with the ones sent to the service,
+// so that the service cannot change the stored tickets.
+func (f *remoteMatchFunction) toMatches(profile *entity.MatchProfile, res []*pb.Match, tickets map[string]*entity.Ticket, backfills map[string]*entity.Backfill) (entity.Matches, error) {
+	matches := make(entity.Matches, 0, len(res))
+	matched := map[string]string{}
+
+	for i, m := range res {
+		if m == nil {
+			return nil, fmt.Errorf("matches[%d] is empty", i)
+		}
+		if m.GetMatchId() == "" {
+			return nil, fmt.Errorf("matches[%d] has no match_id", i)
+		}
+		if len(m.GetTickets()) == 0 {
+			return nil, fmt.Errorf("match %s has no tickets", m.GetMatchId())
+		}
+
+		match := conv.ToMatch(m)
+		match.MatchProfile = profile.Name
+		if match.MatchFunction == "" {
+			match.MatchFunction = f.name
+		}
+
+		for j, t := range match.Tickets {
+			ticket, ok := tickets[t.ID]
+			if !ok {
+				return nil, fmt.Errorf("match %s has ticket %q that was not given", match.MatchID, t.ID)
+			}
+			if other, ok := matched[t.ID]; ok {
+				return nil, fmt.Errorf("ticket %q is in both match %s and match %s", t.ID, other, match.MatchID)
+			}
+			matched[t.ID] = match.MatchID
+			match.Tickets[j] = ticket
+		}
+
+		if match.Backfill != nil && match.Backfill.ID != "" {
+			if _, ok := backfills[match.Backfill.ID]; !ok {
+				return nil, fmt.Errorf("match %s has backfill %q that was not given", match.MatchID, match.Backfill.ID)
+			}
+		}
+
+		matches = append(matches, match)
+	}
+
+	return matches, nil
+}
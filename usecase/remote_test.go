@@ -0,0 +1,460 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/gen/pb"
+	"github.com/HMasataka/errs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testRemoteTimeout = 100 * time.Millisecond
+
+// dialBufconn serves the services registered by register in memory and returns the connection to them.
+func dialBufconn(t *testing.T, register func(*grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	register(server)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("grpc.NewClient() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+// blockUntilDone waits for the call to time out, as a remote service that does not respond.
+func blockUntilDone(ctx context.Context) error {
+	<-ctx.Done()
+	return status.FromContextError(ctx.Err()).Err()
+}
+
+// unavailableOnce fails the first call with Unavailable, as a remote service being restarted.
+func unavailableOnce(call int32) error {
+	if call == 1 {
+		return status.Error(codes.Unavailable, "restarting")
+	}
+	return nil
+}
+
+type fakeEvaluatorServer struct {
+	pb.UnimplementedEvaluatorServiceServer
+
+	calls    atomic.Int32
+	evaluate func(ctx context.Context, call int32, req *pb.EvaluateRequest) (*pb.EvaluateResponse, error)
+}
+
+func (s *fakeEvaluatorServer) Evaluate(ctx context.Context, req *pb.EvaluateRequest) (*pb.EvaluateResponse, error) {
+	return s.evaluate(ctx, s.calls.Add(1), req)
+}
+
+func TestRemoteEvaluator(t *testing.T) {
+	matches := []*entity.Match{
+		{MatchID: "m1", Tickets: entity.Tickets{{ID: "t1"}}},
+		{MatchID: "m2", Tickets: entity.Tickets{{ID: "t1"}}},
+	}
+
+	tests := []struct {
+		name       string
+		maxRetries uint64
+		evaluate   func(ctx context.Context, call int32, req *pb.EvaluateRequest) (*pb.EvaluateResponse, error)
+		want       []string
+		wantErr    *errs.Error
+		wantCode   codes.Code
+		wantCalls  int32
+	}{
+		{
+			name: "evaluated",
+			evaluate: func(ctx context.Context, call int32, req *pb.EvaluateRequest) (*pb.EvaluateResponse, error) {
+				return &pb.EvaluateResponse{MatchIds: []string{req.GetMatches()[1].GetMatchId()}}, nil
+			},
+			want:      []string{"m2"},
+			wantCalls: 1,
+		},
+		{
+			name: "match not given",
+			evaluate: func(ctx context.Context, call int32, req *pb.EvaluateRequest) (*pb.EvaluateResponse, error) {
+				return &pb.EvaluateResponse{MatchIds: []string{"m3"}}, nil
+			},
+			wantErr:   entity.ErrRemoteEvaluatorInvalid,
+			wantCode:  codes.Unknown,
+			wantCalls: 1,
+		},
+		{
+			name:       "retried while unavailable",
+			maxRetries: 1,
+			evaluate: func(ctx context.Context, call int32, req *pb.EvaluateRequest) (*pb.EvaluateResponse, error) {
+				if err := unavailableOnce(call); err != nil {
+					return nil, err
+				}
+				return &pb.EvaluateResponse{MatchIds: []string{"m1"}}, nil
+			},
+			want:      []string{"m1"},
+			wantCalls: 2,
+		},
+		{
+			name:       "retries exhausted",
+			maxRetries: 2,
+			evaluate: func(ctx context.Context, call int32, req *pb.EvaluateRequest) (*pb.EvaluateResponse, error) {
+				return nil, status.Error(codes.Unavailable, "down")
+			},
+			wantErr:   entity.ErrRemoteEvaluatorFailed,
+			wantCode:  codes.Unavailable,
+			wantCalls: 3,
+		},
+		{
+			name:       "permanent error is not retried",
+			maxRetries: 2,
+			evaluate: func(ctx context.Context, call int32, req *pb.EvaluateRequest) (*pb.EvaluateResponse, error) {
+				return nil, status.Error(codes.InvalidArgument, "bad matches")
+			},
+			wantErr:   entity.ErrRemoteEvaluatorFailed,
+			wantCode:  codes.InvalidArgument,
+			wantCalls: 1,
+		},
+		{
+			name: "timeout",
+			evaluate: func(ctx context.Context, call int32, req *pb.EvaluateRequest) (*pb.EvaluateResponse, error) {
+				return nil, blockUntilDone(ctx)
+			},
+			wantErr:   entity.ErrRemoteEvaluatorFailed,
+			wantCode:  codes.DeadlineExceeded,
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &fakeEvaluatorServer{evaluate: tt.evaluate}
+			conn := dialBufconn(t, func(s *grpc.Server) {
+				pb.RegisterEvaluatorServiceServer(s, server)
+			})
+
+			got, err := NewRemoteEvaluator(conn, testRemoteTimeout, tt.maxRetries).Evaluate(context.Background(), matches)
+			checkRemoteError(t, err, tt.wantErr, tt.wantCode)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Evaluate() = %v, want %v", got, tt.want)
+			}
+			if calls := server.calls.Load(); calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+type fakeAssignerServer struct {
+	pb.UnimplementedAssignerServiceServer
+
+	calls  atomic.Int32
+	assign func(ctx context.Context, call int32, req *pb.AssignRequest) (*pb.AssignResponse, error)
+}
+
+func (s *fakeAssignerServer) Assign(ctx context.Context, req *pb.AssignRequest) (*pb.AssignResponse, error) {
+	return s.assign(ctx, s.calls.Add(1), req)
+}
+
+func TestRemoteAssigner(t *testing.T) {
+	matches := entity.Matches{
+		{MatchID: "m1", Tickets: entity.Tickets{{ID: "t1"}, {ID: "t2"}}},
+	}
+
+	assignment := &pb.Assignment{Connection: "10.0.0.1:7777"}
+	respond := func(groups ...*pb.AssignmentGroup) func(context.Context, int32, *pb.AssignRequest) (*pb.AssignResponse, error) {
+		return func(context.Context, int32, *pb.AssignRequest) (*pb.AssignResponse, error) {
+			return &pb.AssignResponse{Assignments: groups}, nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		maxRetries uint64
+		assign     func(ctx context.Context, call int32, req *pb.AssignRequest) (*pb.AssignResponse, error)
+		want       []string
+		wantErr    *errs.Error
+		wantCode   codes.Code
+		wantCalls  int32
+	}{
+		{
+			name:      "assigned",
+			assign:    respond(&pb.AssignmentGroup{TicketIds: []string{"t1", "t2"}, Assignment: assignment}),
+			want:      []string{"t1", "t2"},
+			wantCalls: 1,
+		},
+		{
+			name:      "no assignment",
+			assign:    respond(&pb.AssignmentGroup{TicketIds: []string{"t1"}}),
+			wantErr:   entity.ErrRemoteAssignerInvalid,
+			wantCode:  codes.Unknown,
+			wantCalls: 1,
+		},
+		{
+			name:      "ticket not given",
+			assign:    respond(&pb.AssignmentGroup{TicketIds: []string{"t3"}, Assignment: assignment}),
+			wantErr:   entity.ErrRemoteAssignerInvalid,
+			wantCode:  codes.Unknown,
+			wantCalls: 1,
+		},
+		{
+			name: "ticket assigned twice",
+			assign: respond(
+				&pb.AssignmentGroup{TicketIds: []string{"t1"}, Assignment: assignment},
+				&pb.AssignmentGroup{TicketIds: []string{"t1", "t2"}, Assignment: assignment},
+			),
+			wantErr:   entity.ErrRemoteAssignerInvalid,
+			wantCode:  codes.Unknown,
+			wantCalls: 1,
+		},
+		{
+			name:       "retried while unavailable",
+			maxRetries: 1,
+			assign: func(ctx context.Context, call int32, req *pb.AssignRequest) (*pb.AssignResponse, error) {
+				if err := unavailableOnce(call); err != nil {
+					return nil, err
+				}
+				return respond(&pb.AssignmentGroup{TicketIds: []string{"t1"}, Assignment: assignment})(ctx, call, req)
+			},
+			want:      []string{"t1"},
+			wantCalls: 2,
+		},
+		{
+			name:       "timeout is retried",
+			maxRetries: 1,
+			assign: func(ctx context.Context, call int32, req *pb.AssignRequest) (*pb.AssignResponse, error) {
+				return nil, blockUntilDone(ctx)
+			},
+			wantErr:   entity.ErrRemoteAssignerFailed,
+			wantCode:  codes.DeadlineExceeded,
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &fakeAssignerServer{assign: tt.assign}
+			conn := dialBufconn(t, func(s *grpc.Server) {
+				pb.RegisterAssignerServiceServer(s, server)
+			})
+
+			asgs, err := NewRemoteAssigner(conn, testRemoteTimeout, tt.maxRetries).Assign(context.Background(), matches)
+			checkRemoteError(t, err, tt.wantErr, tt.wantCode)
+
+			var got []string
+			for _, asg := range asgs {
+				if asg.Assignment.Connection != assignment.GetConnection() {
+					t.Errorf("Connection = %q, want %q", asg.Assignment.Connection, assignment.GetConnection())
+				}
+				got = append(got, asg.TicketIds...)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Assign() tickets = %v, want %v", got, tt.want)
+			}
+			if calls := server.calls.Load(); calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+type fakeMatchFunctionServer struct {
+	pb.UnimplementedMatchFunctionServiceServer
+
+	calls atomic.Int32
+	run   func(ctx context.Context, call int32, req *pb.RunRequest) ([]*pb.Match, error)
+}
+
+func (s *fakeMatchFunctionServer) Run(req *pb.RunRequest, stream pb.MatchFunctionService_RunServer) error {
+	matches, err := s.run(stream.Context(), s.calls.Add(1), req)
+	for _, match := range matches {
+		if err := stream.Send(&pb.RunResponse{Match: match}); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func TestRemoteMatchFunction(t *testing.T) {
+	profile := &entity.MatchProfile{Name: "remote", Pools: []*entity.Pool{{Name: "all"}}}
+	poolTickets := map[string]entity.Tickets{
+		"all": {{ID: "t1", SearchFields: &entity.SearchFields{}}, {ID: "t2", SearchFields: &entity.SearchFields{}}},
+	}
+	poolBackfills := map[string]entity.Backfills{
+		"all": {{ID: "b1", SearchFields: &entity.SearchFields{}}},
+	}
+
+	match := func(id, backfillID string, ticketIDs ...string) *pb.Match {
+		m := &pb.Match{MatchId: id}
+		for _, ticketID := range ticketIDs {
+			m.Tickets = append(m.Tickets, &pb.Ticket{Id: ticketID})
+		}
+		if backfillID != "" {
+			m.Backfill = &pb.Backfill{Id: backfillID}
+		}
+		return m
+	}
+	respond := func(matches ...*pb.Match) func(context.Context, int32, *pb.RunRequest) ([]*pb.Match, error) {
+		return func(context.Context, int32, *pb.RunRequest) ([]*pb.Match, error) {
+			return matches, nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		maxRetries uint64
+		run        func(ctx context.Context, call int32, req *pb.RunRequest) ([]*pb.Match, error)
+		want       []string
+		wantErr    *errs.Error
+		wantCode   codes.Code
+		wantCalls  int32
+	}{
+		{
+			name:      "matched",
+			run:       respond(match("m1", "", "t1"), match("m2", "b1", "t2")),
+			want:      []string{"m1", "m2"},
+			wantCalls: 1,
+		},
+		{
+			name:      "ticket not in the pools",
+			run:       respond(match("m1", "", "t1", "t3")),
+			wantErr:   entity.ErrRemoteMatchFunctionInvalid,
+			wantCode:  codes.Unknown,
+			wantCalls: 1,
+		},
+		{
+			name:      "ticket in two matches",
+			run:       respond(match("m1", "", "t1", "t2"), match("m2", "", "t2")),
+			wantErr:   entity.ErrRemoteMatchFunctionInvalid,
+			wantCode:  codes.Unknown,
+			wantCalls: 1,
+		},
+		{
+			name:      "backfill not given",
+			run:       respond(match("m1", "b2", "t1")),
+			wantErr:   entity.ErrRemoteMatchFunctionInvalid,
+			wantCode:  codes.Unknown,
+			wantCalls: 1,
+		},
+		{
+			name:       "retried while unavailable",
+			maxRetries: 1,
+			run: func(ctx context.Context, call int32, req *pb.RunRequest) ([]*pb.Match, error) {
+				if err := unavailableOnce(call); err != nil {
+					return nil, err
+				}
+				return respond(match("m1", "", "t1", "t2"))(ctx, call, req)
+			},
+			want:      []string{"m1"},
+			wantCalls: 2,
+		},
+		{
+			name:       "each call has the timeout",
+			maxRetries: 1,
+			run: func(ctx context.Context, call int32, req *pb.RunRequest) ([]*pb.Match, error) {
+				if call == 1 {
+					return nil, blockUntilDone(ctx)
+				}
+				return respond(match("m1", "", "t1", "t2"))(ctx, call, req)
+			},
+			want:      []string{"m1"},
+			wantCalls: 2,
+		},
+		{
+			name:       "timeout is retried",
+			maxRetries: 1,
+			run: func(ctx context.Context, call int32, req *pb.RunRequest) ([]*pb.Match, error) {
+				return nil, blockUntilDone(ctx)
+			},
+			wantErr:   entity.ErrRemoteMatchFunctionFailed,
+			wantCode:  codes.DeadlineExceeded,
+			wantCalls: 2,
+		},
+		{
+			name:       "permanent error is not retried",
+			maxRetries: 2,
+			run: func(ctx context.Context, call int32, req *pb.RunRequest) ([]*pb.Match, error) {
+				return nil, status.Error(codes.InvalidArgument, "bad profile")
+			},
+			wantErr:   entity.ErrRemoteMatchFunctionFailed,
+			wantCode:  codes.InvalidArgument,
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &fakeMatchFunctionServer{run: tt.run}
+			conn := dialBufconn(t, func(s *grpc.Server) {
+				pb.RegisterMatchFunctionServiceServer(s, server)
+			})
+
+			matches, err := NewRemoteMatchFunction("remote-mmf", conn, testRemoteTimeout, tt.maxRetries).
+				MakeMatches(context.Background(), profile, poolTickets, poolBackfills)
+			checkRemoteError(t, err, tt.wantErr, tt.wantCode)
+
+			var got []string
+			for _, m := range matches {
+				got = append(got, m.MatchID)
+				if m.MatchProfile != profile.Name || m.MatchFunction != "remote-mmf" {
+					t.Errorf("match %s profile = %q, function = %q", m.MatchID, m.MatchProfile, m.MatchFunction)
+				}
+				// The tickets are the ones given, not the ones decoded from the response.
+				for _, ticket := range m.Tickets {
+					if !slices.Contains(poolTickets["all"], ticket) {
+						t.Errorf("match %s ticket %s is not the given ticket", m.MatchID, ticket.ID)
+					}
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("MakeMatches() = %v, want %v", got, tt.want)
+			}
+			if calls := server.calls.Load(); calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+// checkRemoteError checks that the error is wantErr and keeps the status code of the remote call.
+func checkRemoteError(t *testing.T, err error, wantErr *errs.Error, wantCode codes.Code) {
+	t.Helper()
+
+	if wantErr == nil {
+		if err != nil {
+			t.Fatalf("error = %v, want nil", err)
+		}
+		return
+	}
+
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("error = %v, want %v", err, wantErr)
+	}
+	if code := status.Code(err); code != wantCode {
+		t.Errorf("status code = %v, want %v", code, wantCode)
+	}
+}
@@ -2,46 +2,110 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/domain/repository"
 	"github.com/HMasataka/collision/domain/service"
+	"github.com/HMasataka/collision/metrics"
 	"github.com/HMasataka/errs"
 	"github.com/rs/xid"
 )
 
 type TicketUsecase interface {
-	CreateTicket(ctx context.Context, searchFields *entity.SearchFields, extensions []byte) (*entity.Ticket, *errs.Error)
+	CreateTicket(ctx context.Context, searchFields *entity.SearchFields, members []string, extensions []byte) (*entity.Ticket, *errs.Error)
+	GetTicket(ctx context.Context, ticketID string) (*entity.Ticket, *errs.Error)
 	DeleteTicket(ctx context.Context, ticketID string) *errs.Error
 }
 
 type ticketUsecase struct {
-	ticketService service.TicketService
+	ticketRepository repository.TicketRepository
+	ticketService    service.TicketService
+	assignerService  service.AssignerService
+	ticketTTL        time.Duration
 }
 
 func NewTicketUsecase(
+	repositoryContainer *repository.RepositoryContainer,
 	ticketService service.TicketService,
+	assignerService service.AssignerService,
+	ticketTTL time.Duration,
 ) TicketUsecase {
 	return &ticketUsecase{
-		ticketService: ticketService,
+		ticketRepository: repositoryContainer.TicketRepository,
+		ticketService:    ticketService,
+		assignerService:  assignerService,
+		ticketTTL:        ticketTTL,
 	}
 }
 
-func (u *ticketUsecase) CreateTicket(ctx context.Context, searchFields *entity.SearchFields, extensions []byte) (*entity.Ticket, *errs.Error) {
+// CreateTicket creates a ticket for a single player, or for a party when the members are given.
+// A party is matched as one ticket, so all the members always share the same assignment.
+func (u *ticketUsecase) CreateTicket(ctx context.Context, searchFields *entity.SearchFields, members []string, extensions []byte) (*entity.Ticket, *errs.Error) {
+	if err := validateMembers(members); err != nil {
+		return nil, entity.WithCause(entity.ErrTicketInvalid, err)
+	}
+
 	id := xid.New().String()
 
 	ticket := &entity.Ticket{
 		ID:           id,
 		SearchFields: searchFields,
+		Extensions:   extensions,
+		CreatedAt:    time.Now(),
+		Members:      members,
+	}
+
+	if err := u.ticketService.Insert(ctx, ticket, u.ticketTTL); err != nil {
+		return nil, err
+	}
+
+	metrics.TicketsCreated.Inc()
+
+	return ticket, nil
+}
+
+func validateMembers(members []string) error {
+	seen := make(map[string]struct{}, len(members))
+	for _, member := range members {
+		if member == "" {
+			return errors.New("member ID must not be empty")
+		}
+		if _, ok := seen[member]; ok {
+			return fmt.Errorf("member %q is duplicated", member)
+		}
+		seen[member] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetTicket returns the stored ticket with its current assignment.
+// The assignment is nil while the ticket is waiting for a match.
+func (u *ticketUsecase) GetTicket(ctx context.Context, ticketID string) (*entity.Ticket, *errs.Error) {
+	ticket, err := u.ticketRepository.Find(ctx, ticketID)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := u.ticketService.Insert(ctx, ticket, 10*time.Minute); err != nil {
+	assignment, err := u.assignerService.GetAssignment(ctx, ticketID)
+	if err != nil && !errors.Is(err, entity.ErrAssignmentNotFound) {
 		return nil, err
 	}
 
+	ticket.Assignment = assignment
+
 	return ticket, nil
 }
 
 func (u *ticketUsecase) DeleteTicket(ctx context.Context, ticketID string) *errs.Error {
-	return u.ticketService.DeleteTicket(ctx, ticketID)
+	if err := u.ticketService.DeleteTicket(ctx, ticketID); err != nil {
+		return err
+	}
+
+	metrics.TicketsDeleted.Inc()
+
+	return nil
 }
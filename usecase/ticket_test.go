@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/HMasataka/collision/config"
+	"github.com/HMasataka/collision/domain/entity"
+	"github.com/HMasataka/collision/domain/repository"
+	"github.com/HMasataka/collision/domain/service"
+	"github.com/HMasataka/collision/infrastructure/driver"
+	"github.com/HMasataka/collision/infrastructure/memory"
+)
+
+type testUseCases struct {
+	*UseCaseContainer
+	repositories    *repository.RepositoryContainer
+	assignerService service.AssignerService
+}
+
+// newTestUseCases returns the use cases on the in-memory store, running the match functions without an evaluator.
+func newTestUseCases(t *testing.T, matchFunctions map[*entity.MatchProfile]entity.MatchFunction) *testUseCases {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	cfg := config.Default()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	eventSink := driver.NewNopEventSinkDriver()
+
+	repositoryContainer := memory.NewRepository(memory.NewStore(ctx), memory.NewLockerDriver(), cfg)
+	ticketService := service.NewTicketService(repositoryContainer, eventSink)
+	assignerService := service.NewAssignerService(memory.NewAssignmentNotifierDriver(), repositoryContainer, ticketService, eventSink, cfg.Ticket.AssignedTTL)
+
+	return &testUseCases{
+		UseCaseContainer: newContainer(nil, matchFunctions, nil, nil, repositoryContainer, ticketService, assignerService, cfg, logger),
+		repositories:     repositoryContainer,
+		assignerService:  assignerService,
+	}
+}
+
+func TestCreateTicketRoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		extensions []byte
+	}{
+		{name: "no extensions", extensions: nil},
+		{name: "JSON extensions", extensions: []byte(`{"mode":"ranked","region":"ap-northeast-1"}`)},
+		{name: "binary extensions", extensions: []byte{0x00, 0xff, 0x10, 0x80}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			u := newTestUseCases(t, nil).TicketUsecase
+
+			before := time.Now()
+			created, err := u.CreateTicket(ctx, &entity.SearchFields{Tags: []string{"mode.ranked"}}, nil, tt.extensions)
+			if err != nil {
+				t.Fatalf("CreateTicket() error = %v", err)
+			}
+			after := time.Now()
+
+			if created.ID == "" {
+				t.Fatal("CreateTicket() returned a ticket without an ID")
+			}
+			if created.CreatedAt.Before(before) || created.CreatedAt.After(after) {
+				t.Errorf("CreatedAt = %v, want between %v and %v", created.CreatedAt, before, after)
+			}
+
+			got, err := u.GetTicket(ctx, created.ID)
+			if err != nil {
+				t.Fatalf("GetTicket() error = %v", err)
+			}
+
+			if !bytes.Equal(got.Extensions, tt.extensions) {
+				t.Errorf("Extensions = %q, want %q", got.Extensions, tt.extensions)
+			}
+			if !got.CreatedAt.Equal(created.CreatedAt) {
+				t.Errorf("CreatedAt = %v, want %v", got.CreatedAt, created.CreatedAt)
+			}
+			if got.Assignment != nil {
+				t.Errorf("Assignment = %v, want nil", got.Assignment)
+			}
+		})
+	}
+}
+
+func TestCreateTicketMembers(t *testing.T) {
+	tests := []struct {
+		name          string
+		members       []string
+		wantErr       bool
+		wantPartySize int
+	}{
+		{name: "single player", members: nil, wantPartySize: 1},
+		{name: "party", members: []string{"alice", "bob", "carol"}, wantPartySize: 3},
+		{name: "empty member ID", members: []string{"alice", ""}, wantErr: true},
+		{name: "duplicated member", members: []string{"alice", "bob", "alice"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			u := newTestUseCases(t, nil)
+
+			created, err := u.TicketUsecase.CreateTicket(ctx, &entity.SearchFields{}, tt.members, nil)
+			if tt.wantErr {
+				if !errors.Is(err, entity.ErrTicketInvalid) {
+					t.Fatalf("CreateTicket() error = %v, want %v", err, entity.ErrTicketInvalid)
+				}
+
+				// The rejected ticket is not stored.
+				count, err := u.repositories.TicketIDRepository.CountTicketIDs(ctx)
+				if err != nil {
+					t.Fatalf("CountTicketIDs() error = %v", err)
+				}
+				if count != 0 {
+					t.Errorf("CountTicketIDs() = %d, want 0", count)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateTicket() error = %v", err)
+			}
+
+			got, err := u.TicketUsecase.GetTicket(ctx, created.ID)
+			if err != nil {
+				t.Fatalf("GetTicket() error = %v", err)
+			}
+			if got.PartySize() != tt.wantPartySize {
+				t.Errorf("PartySize() = %d, want %d", got.PartySize(), tt.wantPartySize)
+			}
+		})
+	}
+}